@@ -16,27 +16,17 @@ func main() {
 		log.Fatal(err)
 	}
 
-	// List all tickets
-	// https://example.com/api/v2/tickets
-	t, np, err := api.Tickets().List(ctx, nil)
-	if err != nil {
-		log.Fatal(err)
-	}
+	// List all tickets, fetching 100 tickets per page as the iterator advances
+	// https://example.com/api/v2/tickets?per_page=100
+	iter := api.Tickets().ListAll(ctx, &fs.TicketListOptions{PerPage: 100})
 
 	tList := []string{}
-	for _, tick := range t {
+	for iter.Next(ctx) {
+		tick := iter.Value()
 		tList = append(tList, fmt.Sprintf("\n%d - %d", tick.ID, tick.ResponderID))
 	}
-
-	// example querying another page using the returned query parameter
-	if np != "" {
-		t, _, err := api.Tickets().List(ctx, &fs.TicketListOptions{PageQuery: np})
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, tick := range t {
-			tList = append(tList, fmt.Sprintf("\n%d - %d", tick.ID, tick.ResponderID))
-		}
+	if err := iter.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 	fmt.Printf("All Tickets:\nCount: %d\nResults: %v\n", len(tList), tList)
@@ -9,12 +9,16 @@ import (
 	"net/url"
 )
 
-const agentURL = "/api/v2/agents"
+const (
+	agentURL      = "/api/v2/agents"
+	agentFieldURL = "/api/v2/agent_fields"
+)
 
 // AgentService is an interface for interacting with
 // the agent endpoints of the Freshservice API
 type AgentService interface {
 	List(context.Context, QueryFilter) ([]AgentDetails, string, error)
+	ListAll(context.Context, *AgentListFilter) *AgentIterator
 	Create(context.Context, *AgentDetails) (*AgentDetails, error)
 	Get(context.Context, int) (*AgentDetails, error)
 	Update(context.Context, int, *AgentDetails) (*AgentDetails, error)
@@ -22,6 +26,7 @@ type AgentService interface {
 	Deactivate(context.Context, int) (*AgentDetails, error)
 	Reactivate(context.Context, int) (*AgentDetails, error)
 	ConvertToRequester(context.Context, int) (*AgentDetails, error)
+	Fields(context.Context) ([]UserFieldDetails, error)
 }
 
 // AgentServiceClient facilitates requests with the AgentService methods
@@ -55,6 +60,44 @@ func (as *AgentServiceClient) List(ctx context.Context, filter QueryFilter) ([]A
 	return res.List, HasNextPage(resp), nil
 }
 
+// ListAll returns an iterator over every Freshservice agent matching the
+// options passed in, requesting additional pages as the iterator advances
+func (as *AgentServiceClient) ListAll(ctx context.Context, opts *AgentListFilter) *AgentIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &AgentIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := as.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// AgentIterator iterates over a paginated list of Freshservice agents
+type AgentIterator struct {
+	pager
+	page []AgentDetails
+}
+
+// Value returns the agent the iterator currently points at
+func (it *AgentIterator) Value() AgentDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max agents.
+// A max of zero or less will collect every remaining agent.
+func (it *AgentIterator) Collect(ctx context.Context, max int) ([]AgentDetails, error) {
+	var list []AgentDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
 // Get a specific Freshservice agent
 func (as *AgentServiceClient) Get(ctx context.Context, id int) (*AgentDetails, error) {
 	url := &url.URL{
@@ -215,3 +258,25 @@ func (as *AgentServiceClient) ConvertToRequester(ctx context.Context, id int) (*
 
 	return &res.Details, nil
 }
+
+// Fields returns the definitions of the default and custom agent fields,
+// including the choices of dropdown fields
+func (as *AgentServiceClient) Fields(ctx context.Context) ([]UserFieldDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   as.client.Domain,
+		Path:   agentFieldURL,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AgentFields{}
+	if _, err := as.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
@@ -18,36 +18,42 @@ type Agent struct {
 
 // AgentDetails contains the details of a specific Freshservice agent
 type AgentDetails struct {
-	ID                    int         `json:"id"`
-	FirstName             string      `json:"first_name"`
-	LastName              string      `json:"last_name"`
-	Occasional            bool        `json:"occasional"`
-	Active                bool        `json:"active"`
-	JobTitle              string      `json:"job_title"`
-	Email                 string      `json:"email"`
-	WorkPhoneNumber       string      `json:"work_phone_number"`
-	MobilePhoneNumber     string      `json:"mobile_phone_number"`
-	ReportingManagerID    int         `json:"reporting_manager_id"`
-	Address               string      `json:"address"`
-	TimeZone              string      `json:"time_zone"`
-	TimeFormat            string      `json:"time_format"`
-	Language              string      `json:"language"`
-	LocationID            int         `json:"location_id"`
-	BackgroundInformation string      `json:"background_information"`
-	ScoreboardLevelID     int         `json:"scoreboard_level_id"`
-	GroupIds              []int       `json:"group_ids"` // being deprecated by freshservice
-	MemberOf              []int       `json:"member_of"`
-	ObserverOf            []int       `json:"observer_of"`
-	RoleIds               []int       `json:"role_ids"` // being deprecated by freshservice
-	Roles                 []AgentRole `json:"roles"`
-	LastLoginAt           time.Time   `json:"last_login_at"`
-	LastActiveAt          time.Time   `json:"last_active_at"`
-	CustomFields          struct {
-		House string `json:"house"`
-	} `json:"custom_fields"`
-	HasLoggedIn bool `json:"has_logged_in"`
+	ID                    int          `json:"id"`
+	FirstName             string       `json:"first_name"`
+	LastName              string       `json:"last_name"`
+	Occasional            bool         `json:"occasional"`
+	Active                bool         `json:"active"`
+	JobTitle              string       `json:"job_title"`
+	Email                 string       `json:"email"`
+	WorkPhoneNumber       string       `json:"work_phone_number"`
+	MobilePhoneNumber     string       `json:"mobile_phone_number"`
+	ReportingManagerID    int          `json:"reporting_manager_id"`
+	Address               string       `json:"address"`
+	TimeZone              string       `json:"time_zone"`
+	TimeFormat            string       `json:"time_format"`
+	Language              string       `json:"language"`
+	LocationID            int          `json:"location_id"`
+	BackgroundInformation string       `json:"background_information"`
+	ScoreboardLevelID     int          `json:"scoreboard_level_id"`
+	GroupIds              []int        `json:"group_ids"` // being deprecated by freshservice
+	MemberOf              []int        `json:"member_of"`
+	ObserverOf            []int        `json:"observer_of"`
+	RoleIds               []int        `json:"role_ids"` // being deprecated by freshservice
+	Roles                 []AgentRole  `json:"roles"`
+	LastLoginAt           time.Time    `json:"last_login_at"`
+	LastActiveAt          time.Time    `json:"last_active_at"`
+	CustomFields          CustomFields `json:"custom_fields,omitempty"`
+	HasLoggedIn           bool         `json:"has_logged_in"`
 }
 
+// Scopes an agent role can be assigned with
+const (
+	ScopeEntireHelpdesk  = "entire_helpdesk"
+	ScopeMemberGroups    = "member_groups"
+	ScopeSpecifiedGroups = "specified_groups"
+	ScopeAssignedItems   = "assigned_items"
+)
+
 // AgentRole represents a Freshservice role that can be assigned to an agent
 type AgentRole struct {
 	RoleID          int    `json:"role_id"`
@@ -55,21 +61,26 @@ type AgentRole struct {
 	Groups          []int  `json:"groups"`
 }
 
-// Validate will confirm that an agent role is valud
+// Validate will confirm that an agent role is valid. Use Roles().ValidateAgent
+// to also check the role and group IDs against those configured in Freshservice.
 func (ar *AgentRole) Validate() error {
 	validScopes := []string{
-		"entire_helpdesk",
-		"member_groups",
-		"assigned_items",
-		"speciﬁed_groups",
+		ScopeEntireHelpdesk,
+		ScopeMemberGroups,
+		ScopeAssignedItems,
+		ScopeSpecifiedGroups,
 	}
 
 	if !StringInSlice(ar.AssignmentScope, validScopes) {
 		return fmt.Errorf("Agent assignment scope is invalid; choose from %s", strings.Join(validScopes, ","))
 	}
 
-	if len(ar.Groups) > 0 && ar.AssignmentScope != "specified_groups" {
-		return fmt.Errorf("Agent role groups are only applicable only if speciﬁed_groups is selected not %s", ar.AssignmentScope)
+	if len(ar.Groups) > 0 && ar.AssignmentScope != ScopeSpecifiedGroups {
+		return fmt.Errorf("Agent role groups are only applicable if %s is selected not %s", ScopeSpecifiedGroups, ar.AssignmentScope)
+	}
+
+	if len(ar.Groups) == 0 && ar.AssignmentScope == ScopeSpecifiedGroups {
+		return fmt.Errorf("Agent role groups are required when %s is selected", ScopeSpecifiedGroups)
 	}
 
 	return nil
@@ -78,6 +89,8 @@ func (ar *AgentRole) Validate() error {
 // AgentListFilter holds the filters available when listing Freservice agents
 type AgentListFilter struct {
 	PageQuery   string
+	PerPage     int
+	Query       *Query
 	Email       *string
 	MobilePhone *int
 	WorkPhone   *int
@@ -93,6 +106,14 @@ func (af *AgentListFilter) QueryString() string {
 		qs = append(qs, af.PageQuery)
 	}
 
+	if af.PerPage > 0 {
+		qs = append(qs, perPageQuery(af.PerPage))
+	}
+
+	if af.Query != nil {
+		qs = append(qs, af.Query.QueryString())
+	}
+
 	switch {
 	case af.Email != nil:
 		qs = append(qs, fmt.Sprintf("email=%s", *af.Email))
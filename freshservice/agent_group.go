@@ -0,0 +1,282 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const agentGroupURL = "/api/v2/groups"
+
+var errNoMembership = errors.New("agent group membership is required")
+
+// AgentGroupService is an interface for interacting with
+// the agent group endpoints of the Freshservice API
+type AgentGroupService interface {
+	List(context.Context, QueryFilter) ([]AgentGroupDetails, string, error)
+	ListAll(context.Context, *AgentGroupListOptions) *AgentGroupIterator
+	Create(context.Context, *AgentGroupDetails) (*AgentGroupDetails, error)
+	Get(context.Context, int) (*AgentGroupDetails, error)
+	Update(context.Context, int, *AgentGroupDetails) (*AgentGroupDetails, error)
+	Delete(context.Context, int) error
+	SetMembers(context.Context, int, *AgentGroupMembership) (*AgentGroupDetails, error)
+	AddMembers(context.Context, int, *AgentGroupMembership) (*AgentGroupDetails, error)
+	RemoveMembers(context.Context, int, *AgentGroupMembership) (*AgentGroupDetails, error)
+}
+
+// AgentGroupServiceClient facilitates requests with the AgentGroupService methods
+type AgentGroupServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice agent groups
+func (gs *AgentGroupServiceClient) List(ctx context.Context, filter QueryFilter) ([]AgentGroupDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   gs.client.Domain,
+		Path:   agentGroupURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &AgentGroups{}
+	resp, err := gs.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice agent group matching the
+// options passed in, requesting additional pages as the iterator advances
+func (gs *AgentGroupServiceClient) ListAll(ctx context.Context, opts *AgentGroupListOptions) *AgentGroupIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &AgentGroupIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := gs.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// AgentGroupIterator iterates over a paginated list of Freshservice agent groups
+type AgentGroupIterator struct {
+	pager
+	page []AgentGroupDetails
+}
+
+// Value returns the agent group the iterator currently points at
+func (it *AgentGroupIterator) Value() AgentGroupDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max agent groups.
+// A max of zero or less will collect every remaining agent group.
+func (it *AgentGroupIterator) Collect(ctx context.Context, max int) ([]AgentGroupDetails, error) {
+	var list []AgentGroupDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Create a new Freshservice agent group
+func (gs *AgentGroupServiceClient) Create(ctx context.Context, gd *AgentGroupDetails) (*AgentGroupDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   gs.client.Domain,
+		Path:   agentGroupURL,
+	}
+
+	groupContent, err := json.Marshal(gd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(groupContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AgentGroup{}
+	if _, err := gs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific Freshservice agent group
+func (gs *AgentGroupServiceClient) Get(ctx context.Context, id int) (*AgentGroupDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   gs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", agentGroupURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AgentGroup{}
+	if _, err := gs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice agent group. Empty member lists are not sent,
+// use SetMembers or RemoveMembers to remove the last agent of a role.
+func (gs *AgentGroupServiceClient) Update(ctx context.Context, id int, gd *AgentGroupDetails) (*AgentGroupDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   gs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", agentGroupURL, id),
+	}
+
+	groupContent, err := json.Marshal(gd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(groupContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AgentGroup{}
+	if _, err := gs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice agent group
+// Note: Deleted agent groups are permanently lost.
+func (gs *AgentGroupServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   gs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", agentGroupURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := gs.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetMembers replaces the members, observers and leaders of an agent group
+// with the agents passed in, removing every agent not listed. That includes
+// agents still pending approval in a group that requires it.
+func (gs *AgentGroupServiceClient) SetMembers(ctx context.Context, id int, m *AgentGroupMembership) (*AgentGroupDetails, error) {
+	if m == nil {
+		return nil, errNoMembership
+	}
+
+	url := &url.URL{
+		Scheme: "https",
+		Host:   gs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", agentGroupURL, id),
+	}
+
+	// addIDs always returns a non-nil list so that empty roles are sent and cleared
+	members := &agentGroupMembers{
+		Members:   addIDs(nil, m.Members),
+		Observers: addIDs(nil, m.Observers),
+		Leaders:   addIDs(nil, m.Leaders),
+	}
+
+	groupContent, err := json.Marshal(members)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(groupContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AgentGroup{}
+	if _, err := gs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// AddMembers adds the agents passed in to an agent group in the role they
+// are listed under, keeping the existing members of the group along with
+// those pending approval. The group is read before its membership is
+// replaced, so a change made by someone else in between is lost.
+func (gs *AgentGroupServiceClient) AddMembers(ctx context.Context, id int, m *AgentGroupMembership) (*AgentGroupDetails, error) {
+	if m == nil {
+		return nil, errNoMembership
+	}
+
+	gd, err := gs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cur := gd.membership()
+	return gs.SetMembers(ctx, id, &AgentGroupMembership{
+		Members:   addIDs(cur.Members, m.Members),
+		Observers: addIDs(cur.Observers, m.Observers),
+		Leaders:   addIDs(cur.Leaders, m.Leaders),
+	})
+}
+
+// RemoveMembers removes the agents passed in from the role they are listed
+// under in an agent group, keeping the other members of the group along with
+// those pending approval. The group is read before its membership is
+// replaced, so a change made by someone else in between is lost.
+func (gs *AgentGroupServiceClient) RemoveMembers(ctx context.Context, id int, m *AgentGroupMembership) (*AgentGroupDetails, error) {
+	if m == nil {
+		return nil, errNoMembership
+	}
+
+	gd, err := gs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cur := gd.membership()
+	return gs.SetMembers(ctx, id, &AgentGroupMembership{
+		Members:   removeIDs(cur.Members, m.Members),
+		Observers: removeIDs(cur.Observers, m.Observers),
+		Leaders:   removeIDs(cur.Leaders, m.Leaders),
+	})
+}
@@ -0,0 +1,121 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+// Durations after which an unassigned ticket in a group is escalated
+const (
+	Unassigned30Minutes = "30m"
+	Unassigned1Hour     = "1h"
+	Unassigned2Hours    = "2h"
+	Unassigned4Hours    = "4h"
+	Unassigned8Hours    = "8h"
+	Unassigned12Hours   = "12h"
+	Unassigned1Day      = "1d"
+	Unassigned2Days     = "2d"
+	Unassigned3Days     = "3d"
+)
+
+// AgentGroups holds a list of Freshservice agent groups
+type AgentGroups struct {
+	List []AgentGroupDetails `json:"groups"`
+}
+
+// AgentGroup holds the details of a specific Freshservice agent group
+type AgentGroup struct {
+	Details AgentGroupDetails `json:"group"`
+}
+
+// AgentGroupDetails are the details related to a specific agent group. Tickets
+// left unassigned in the group for UnassignedFor are escalated to the agent ID
+// set as EscalateTo. Members of a Restricted group that requires approval are
+// only added once approved and are listed as pending until then.
+type AgentGroupDetails struct {
+	ID                       int        `json:"id,omitempty"` // Read-Only
+	Name                     string     `json:"name,omitempty"`
+	Description              string     `json:"description,omitempty"`
+	EscalateTo               int        `json:"escalate_to,omitempty"`
+	UnassignedFor            string     `json:"unassigned_for,omitempty"`
+	BusinessHoursID          int        `json:"business_hours_id,omitempty"`
+	AutoTicketAssign         *bool      `json:"auto_ticket_assign,omitempty"`
+	Restricted               *bool      `json:"restricted,omitempty"`
+	ApprovalRequired         *bool      `json:"approval_required,omitempty"`
+	Members                  []int      `json:"members,omitempty"`
+	Observers                []int      `json:"observers,omitempty"`
+	Leaders                  []int      `json:"leaders,omitempty"`
+	MembersPendingApproval   []int      `json:"members_pending_approval,omitempty"`   // Read-Only
+	ObserversPendingApproval []int      `json:"observers_pending_approval,omitempty"` // Read-Only
+	LeadersPendingApproval   []int      `json:"leaders_pending_approval,omitempty"`   // Read-Only
+	CreatedAt                *time.Time `json:"created_at,omitempty"`                 // Read-Only
+	UpdatedAt                *time.Time `json:"updated_at,omitempty"`                 // Read-Only
+}
+
+// AgentGroupMembership lists agent IDs by the role they hold within an agent group
+type AgentGroupMembership struct {
+	Members   []int
+	Observers []int
+	Leaders   []int
+}
+
+// membership returns the agents of the group by role, including those pending
+// approval so that replacing the membership does not withdraw their requests
+func (gd *AgentGroupDetails) membership() *AgentGroupMembership {
+	return &AgentGroupMembership{
+		Members:   addIDs(gd.Members, gd.MembersPendingApproval),
+		Observers: addIDs(gd.Observers, gd.ObserversPendingApproval),
+		Leaders:   addIDs(gd.Leaders, gd.LeadersPendingApproval),
+	}
+}
+
+// agentGroupMembers is the payload to update the membership of an agent
+// group, where empty lists are sent so that the last agent can be removed
+type agentGroupMembers struct {
+	Members   []int `json:"members"`
+	Observers []int `json:"observers"`
+	Leaders   []int `json:"leaders"`
+}
+
+// AgentGroupListOptions holds the available options that can be
+// passed when requesting a list of Freshservice agent groups
+type AgentGroupListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *AgentGroupListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
+
+// addIDs returns the IDs with any of the additions not yet present appended
+func addIDs(ids []int, additions []int) []int {
+	res := append([]int{}, ids...)
+	for _, a := range additions {
+		if !intInSlice(a, res) {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+// removeIDs returns the IDs without any of the removals
+func removeIDs(ids []int, removals []int) []int {
+	res := []int{}
+	for _, id := range ids {
+		if !intInSlice(id, removals) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
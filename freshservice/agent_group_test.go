@@ -0,0 +1,42 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestAgentGroupMembership(t *testing.T) {
+	var sent []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/groups/8", r.URL.Path)
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"group":{"id":8,"members":[1,2],"observers":[3],"leaders":[1],"members_pending_approval":[5]}}`)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		sent = append(sent, string(body))
+		fmt.Fprintf(w, `{"group":{"id":8,"escalate_to":1,"unassigned_for":"30m"}}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	_, err := c.AgentGroups().AddMembers(ctx, 8, &freshservice.AgentGroupMembership{Members: []int{2, 4}})
+	assert.Nil(t, err)
+
+	_, err = c.AgentGroups().RemoveMembers(ctx, 8, &freshservice.AgentGroupMembership{Observers: []int{3}})
+	assert.Nil(t, err)
+
+	assert.Len(t, sent, 2)
+	assert.JSONEq(t, `{"members":[1,2,5,4],"observers":[3],"leaders":[1]}`, sent[0])
+	assert.JSONEq(t, `{"members":[1,2,5],"observers":[],"leaders":[1]}`, sent[1])
+
+	_, err = c.AgentGroups().AddMembers(ctx, 8, nil)
+	assert.NotNil(t, err)
+	assert.Len(t, sent, 2)
+}
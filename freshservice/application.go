@@ -1,7 +1,9 @@
 package freshservice
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -14,10 +16,28 @@ const applicationURL = "/api/v2/applications"
 // the application endpoints of the Freshservice API
 type ApplicationService interface {
 	List(context.Context, QueryFilter) ([]ApplicationDetails, string, error)
+	ListAll(context.Context, *ApplicationListOptions) *ApplicationIterator
 	Get(context.Context, int64) (*ApplicationDetails, error)
+	Create(context.Context, *ApplicationDetails) (*ApplicationDetails, error)
+	Update(context.Context, int64, *ApplicationDetails) (*ApplicationDetails, error)
+	Delete(context.Context, int64) error
 	ListLicenses(context.Context, int64) ([]LicensesDetails, error)
+	ListLicensesPage(context.Context, int64, QueryFilter) ([]LicensesDetails, string, error)
+	ListAllLicenses(context.Context, int64, *ApplicationListOptions) *LicenseIterator
+	AddLicense(context.Context, int64, *LicensesDetails) (*LicensesDetails, error)
+	UpdateLicense(context.Context, int64, int, *LicensesDetails) (*LicensesDetails, error)
+	DeleteLicense(context.Context, int64, int) error
 	ListUsers(context.Context, int64) ([]ApplicationUserDetails, error)
+	ListUsersPage(context.Context, int64, QueryFilter) ([]ApplicationUserDetails, string, error)
+	ListAllUsers(context.Context, int64, *ApplicationListOptions) *ApplicationUserIterator
+	AddUsers(context.Context, int64, []ApplicationUserDetails) ([]ApplicationUserDetails, error)
+	UpdateUsers(context.Context, int64, []ApplicationUserDetails) ([]ApplicationUserDetails, error)
+	RemoveUsers(context.Context, int64, ...int) error
 	ListInstallations(context.Context, int64) ([]ApplicationInstallationDetails, error)
+	ListInstallationsPage(context.Context, int64, QueryFilter) ([]ApplicationInstallationDetails, string, error)
+	ListAllInstallations(context.Context, int64, *ApplicationListOptions) *ApplicationInstallationIterator
+	AddInstallation(context.Context, int64, *ApplicationInstallationDetails) (*ApplicationInstallationDetails, error)
+	RemoveInstallations(context.Context, int64, ...int) error
 }
 
 // ApplicationServiceClient facilitates requests with the TicketService methods
@@ -54,6 +74,44 @@ func (a *ApplicationServiceClient) List(ctx context.Context, filter QueryFilter)
 	return res.List, HasNextPage(resp), nil
 }
 
+// ListAll returns an iterator over every Freshservice application matching the
+// options passed in, requesting additional pages as the iterator advances
+func (a *ApplicationServiceClient) ListAll(ctx context.Context, opts *ApplicationListOptions) *ApplicationIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &ApplicationIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := a.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// ApplicationIterator iterates over a paginated list of Freshservice applications
+type ApplicationIterator struct {
+	pager
+	page []ApplicationDetails
+}
+
+// Value returns the application the iterator currently points at
+func (it *ApplicationIterator) Value() ApplicationDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max applications.
+// A max of zero or less will collect every remaining application.
+func (it *ApplicationIterator) Collect(ctx context.Context, max int) ([]ApplicationDetails, error) {
+	var list []ApplicationDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
 // Get a specific all application
 func (a *ApplicationServiceClient) Get(ctx context.Context, appID int64) (*ApplicationDetails, error) {
 
@@ -76,70 +134,510 @@ func (a *ApplicationServiceClient) Get(ctx context.Context, appID int64) (*Appli
 	return &res.Details, nil
 }
 
+// Create a new Freshservice application
+func (a *ApplicationServiceClient) Create(ctx context.Context, ad *ApplicationDetails) (*ApplicationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   applicationURL,
+	}
+
+	applicationContent, err := json.Marshal(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(applicationContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Application{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice application
+func (a *ApplicationServiceClient) Update(ctx context.Context, appID int64, ad *ApplicationDetails) (*ApplicationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", applicationURL, appID),
+	}
+
+	applicationContent, err := json.Marshal(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(applicationContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Application{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice application
+// Note: Deleted applications are permanently lost along with their installations and users.
+func (a *ApplicationServiceClient) Delete(ctx context.Context, appID int64) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", applicationURL, appID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ListLicenses lists all the licenses for an application
+// Note: Only the first page is returned, use ListLicensesPage or ListAllLicenses to page through them.
 func (a *ApplicationServiceClient) ListLicenses(ctx context.Context, appID int64) ([]LicensesDetails, error) {
+	list, _, err := a.ListLicensesPage(ctx, appID, nil)
+	return list, err
+}
 
+// ListLicensesPage lists a single page of the licenses of an application along with the
+// query for the next page, empty on the last page
+func (a *ApplicationServiceClient) ListLicensesPage(ctx context.Context, appID int64, filter QueryFilter) ([]LicensesDetails, string, error) {
 	url := &url.URL{
 		Scheme: "https",
 		Host:   a.client.Domain,
 		Path:   fmt.Sprintf("%s/%d/licenses", applicationURL, appID),
 	}
 
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	res := &Licenses{}
-	if _, err = a.client.makeRequest(req, res); err != nil {
+	resp, err := a.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAllLicenses returns an iterator over every license of an application,
+// requesting additional pages as the iterator advances
+func (a *ApplicationServiceClient) ListAllLicenses(ctx context.Context, appID int64, opts *ApplicationListOptions) *LicenseIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &LicenseIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := a.ListLicensesPage(ctx, appID, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// LicenseIterator iterates over a paginated list of application licenses
+type LicenseIterator struct {
+	pager
+	page []LicensesDetails
+}
+
+// Value returns the license the iterator currently points at
+func (it *LicenseIterator) Value() LicensesDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max licenses.
+// A max of zero or less will collect every remaining license.
+func (it *LicenseIterator) Collect(ctx context.Context, max int) ([]LicensesDetails, error) {
+	var list []LicensesDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// AddLicense adds a license, referencing a contract, to an application
+func (a *ApplicationServiceClient) AddLicense(ctx context.Context, appID int64, ld *LicensesDetails) (*LicensesDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/licenses", applicationURL, appID),
+	}
+
+	licenseContent, err := json.Marshal(ld)
+	if err != nil {
 		return nil, err
 	}
 
-	return res.List, nil
+	body := bytes.NewReader(licenseContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &License{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// UpdateLicense updates a specific license of an application
+func (a *ApplicationServiceClient) UpdateLicense(ctx context.Context, appID int64, licenseID int, ld *LicensesDetails) (*LicensesDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/licenses/%d", applicationURL, appID, licenseID),
+	}
+
+	licenseContent, err := json.Marshal(ld)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(licenseContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &License{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// DeleteLicense removes a specific license from an application
+func (a *ApplicationServiceClient) DeleteLicense(ctx context.Context, appID int64, licenseID int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/licenses/%d", applicationURL, appID, licenseID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // ListUsers lists all the users of an application
+// Note: Only the first page is returned, use ListUsersPage or ListAllUsers to page through them.
 func (a *ApplicationServiceClient) ListUsers(ctx context.Context, appID int64) ([]ApplicationUserDetails, error) {
+	list, _, err := a.ListUsersPage(ctx, appID, nil)
+	return list, err
+}
 
+// ListUsersPage lists a single page of the users of an application along with the
+// query for the next page, empty on the last page
+func (a *ApplicationServiceClient) ListUsersPage(ctx context.Context, appID int64, filter QueryFilter) ([]ApplicationUserDetails, string, error) {
 	url := &url.URL{
 		Scheme: "https",
 		Host:   a.client.Domain,
 		Path:   fmt.Sprintf("%s/%d/users", applicationURL, appID),
 	}
 
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &ApplicationUsers{}
+	resp, err := a.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAllUsers returns an iterator over every user of an application,
+// requesting additional pages as the iterator advances
+func (a *ApplicationServiceClient) ListAllUsers(ctx context.Context, appID int64, opts *ApplicationListOptions) *ApplicationUserIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &ApplicationUserIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := a.ListUsersPage(ctx, appID, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// ApplicationUserIterator iterates over a paginated list of application users
+type ApplicationUserIterator struct {
+	pager
+	page []ApplicationUserDetails
+}
+
+// Value returns the user the iterator currently points at
+func (it *ApplicationUserIterator) Value() ApplicationUserDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max users.
+// A max of zero or less will collect every remaining user.
+func (it *ApplicationUserIterator) Collect(ctx context.Context, max int) ([]ApplicationUserDetails, error) {
+	var list []ApplicationUserDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// AddUsers adds users, optionally with a license allocated, to an application
+func (a *ApplicationServiceClient) AddUsers(ctx context.Context, appID int64, users []ApplicationUserDetails) ([]ApplicationUserDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/users", applicationURL, appID),
+	}
+
+	userContent, err := json.Marshal(&ApplicationUsers{List: users})
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(userContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
 	res := &ApplicationUsers{}
-	if _, err = a.client.makeRequest(req, res); err != nil {
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// UpdateUsers updates the users of an application, matched by their UserID,
+// e.g. to record when the application was last used
+func (a *ApplicationServiceClient) UpdateUsers(ctx context.Context, appID int64, users []ApplicationUserDetails) ([]ApplicationUserDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/users", applicationURL, appID),
+	}
+
+	userContent, err := json.Marshal(&ApplicationUsers{List: users})
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(userContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ApplicationUsers{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
 		return nil, err
 	}
 
 	return res.List, nil
 }
 
+// RemoveUsers removes the users matching the user IDs passed in from an application
+func (a *ApplicationServiceClient) RemoveUsers(ctx context.Context, appID int64, ids ...int) error {
+	if len(ids) == 0 {
+		return errNoIDs
+	}
+
+	url := &url.URL{
+		Scheme:   "https",
+		Host:     a.client.Domain,
+		Path:     fmt.Sprintf("%s/%d/users/remove", applicationURL, appID),
+		RawQuery: "user_ids=" + joinIDs(ids),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ListInstallations lists all the installations of an application
+// Note: Only the first page is returned, use ListInstallationsPage or ListAllInstallations to page through them.
 func (a *ApplicationServiceClient) ListInstallations(ctx context.Context, appID int64) ([]ApplicationInstallationDetails, error) {
+	list, _, err := a.ListInstallationsPage(ctx, appID, nil)
+	return list, err
+}
 
+// ListInstallationsPage lists a single page of the installations of an application along with the
+// query for the next page, empty on the last page
+func (a *ApplicationServiceClient) ListInstallationsPage(ctx context.Context, appID int64, filter QueryFilter) ([]ApplicationInstallationDetails, string, error) {
 	url := &url.URL{
 		Scheme: "https",
 		Host:   a.client.Domain,
 		Path:   fmt.Sprintf("%s/%d/installations", applicationURL, appID),
 	}
 
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	res := &ApplicationInstallations{}
-	if _, err = a.client.makeRequest(req, res); err != nil {
+	resp, err := a.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAllInstallations returns an iterator over every installation of an application,
+// requesting additional pages as the iterator advances
+func (a *ApplicationServiceClient) ListAllInstallations(ctx context.Context, appID int64, opts *ApplicationListOptions) *ApplicationInstallationIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &ApplicationInstallationIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := a.ListInstallationsPage(ctx, appID, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// ApplicationInstallationIterator iterates over a paginated list of application installations
+type ApplicationInstallationIterator struct {
+	pager
+	page []ApplicationInstallationDetails
+}
+
+// Value returns the installation the iterator currently points at
+func (it *ApplicationInstallationIterator) Value() ApplicationInstallationDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max installations.
+// A max of zero or less will collect every remaining installation.
+func (it *ApplicationInstallationIterator) Collect(ctx context.Context, max int) ([]ApplicationInstallationDetails, error) {
+	var list []ApplicationInstallationDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// AddInstallation records an installation of an application on the
+// device set as the InstallationMachineID
+func (a *ApplicationServiceClient) AddInstallation(ctx context.Context, appID int64, inst *ApplicationInstallationDetails) (*ApplicationInstallationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/installations", applicationURL, appID),
+	}
+
+	installationContent, err := json.Marshal(inst)
+	if err != nil {
 		return nil, err
 	}
 
-	return res.List, nil
+	body := bytes.NewReader(installationContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ApplicationInstallation{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// RemoveInstallations removes the installations of an application
+// from the devices matching the display IDs passed in
+func (a *ApplicationServiceClient) RemoveInstallations(ctx context.Context, appID int64, ids ...int) error {
+	if len(ids) == 0 {
+		return errNoIDs
+	}
+
+	url := &url.URL{
+		Scheme:   "https",
+		Host:     a.client.Domain,
+		Path:     fmt.Sprintf("%s/%d/installations/remove", applicationURL, appID),
+		RawQuery: "device_ids=" + joinIDs(ids),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // QueryString allows us to pass TicketListOptions as a QueryFilter and
@@ -151,5 +649,9 @@ func (opts *ApplicationListOptions) QueryString() string {
 		qs = append(qs, opts.PageQuery)
 	}
 
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
 	return strings.Join(qs, "&")
 }
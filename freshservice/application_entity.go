@@ -1,6 +1,9 @@
 package freshservice
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Applications holds a list of Freshservice application details
 type Applications struct {
@@ -14,21 +17,32 @@ type Application struct {
 
 // ApplicationDetails are the details related to a specific application in Freshservice
 type ApplicationDetails struct {
-	AdditionalData    AdditionalData `json:"additional_data"`
-	UserCount         int            `json:"user_count"`
-	InstallationCount int            `json:"installation_count"`
-	ID                int64          `json:"id"`
-	Name              string         `json:"name"`
-	Description       interface{}    `json:"description"`
-	Notes             interface{}    `json:"notes"`
-	PublisherID       int64          `json:"publisher_id"`
-	CreatedAt         time.Time      `json:"created_at"`
-	UpdatedAt         time.Time      `json:"updated_at"`
-	ApplicationType   string         `json:"application_type"`
-	Status            string         `json:"status"`
-	ManagedByID       int64          `json:"managed_by_id"`
-	Category          string         `json:"category"`
-	Sources           []interface{}  `json:"sources"`
+	AdditionalData    AdditionalData `json:"additional_data"`              // Read-Only
+	UserCount         int            `json:"user_count,omitempty"`         // Read-Only
+	InstallationCount int            `json:"installation_count,omitempty"` // Read-Only
+	ID                int64          `json:"id,omitempty"`                 // Read-Only
+	Name              string         `json:"name,omitempty"`
+	Description       interface{}    `json:"description,omitempty"`
+	Notes             interface{}    `json:"notes,omitempty"`
+	PublisherID       int64          `json:"publisher_id,omitempty"`
+	CreatedAt         time.Time      `json:"created_at"` // Read-Only
+	UpdatedAt         time.Time      `json:"updated_at"` // Read-Only
+	ApplicationType   string         `json:"application_type,omitempty"`
+	Status            string         `json:"status,omitempty"`
+	ManagedByID       int64          `json:"managed_by_id,omitempty"`
+	Category          string         `json:"category,omitempty"`
+	Sources           []interface{}  `json:"sources,omitempty"` // Read-Only
+}
+
+// MarshalJSON leaves the read-only fields out of an application payload
+func (ad ApplicationDetails) MarshalJSON() ([]byte, error) {
+	type details ApplicationDetails
+	return json.Marshal(struct {
+		details
+		AdditionalData *AdditionalData `json:"additional_data,omitempty"`
+		CreatedAt      *time.Time      `json:"created_at,omitempty"`
+		UpdatedAt      *time.Time      `json:"updated_at,omitempty"`
+	}{details: details(ad)})
 }
 
 type AdditionalData struct {
@@ -41,6 +55,7 @@ type AdditionalData struct {
 // passed when requesting a list of Freshservice Applications
 type ApplicationListOptions struct {
 	PageQuery string
+	PerPage   int
 }
 
 // Licenses holds a list of Freshservice licenses for an application
@@ -50,10 +65,25 @@ type Licenses struct {
 
 // LicenseDetails holds the details of a specific Freshservice application license
 type LicensesDetails struct {
-	ID          int       `json:"id"`
-	ContractID  string    `json:"contract_id"`
-	CreatedTime time.Time `json:"created_time"`
-	UpdatedTime time.Time `json:"updated_time"`
+	ID          int       `json:"id,omitempty"` // Read-Only
+	ContractID  string    `json:"contract_id,omitempty"`
+	CreatedTime time.Time `json:"created_time"` // Read-Only
+	UpdatedTime time.Time `json:"updated_time"` // Read-Only
+}
+
+// License holds the details of a specific Freshservice application license
+type License struct {
+	Details LicensesDetails `json:"license"`
+}
+
+// MarshalJSON leaves the read-only timestamps out of a license payload
+func (ld LicensesDetails) MarshalJSON() ([]byte, error) {
+	type details LicensesDetails
+	return json.Marshal(struct {
+		details
+		CreatedTime *time.Time `json:"created_time,omitempty"`
+		UpdatedTime *time.Time `json:"updated_time,omitempty"`
+	}{details: details(ld)})
 }
 
 // ApplicationUsers holds a list of Freshservice application users
@@ -63,15 +93,33 @@ type ApplicationUsers struct {
 
 // ApplicationUserDetails holds the details of users for a specific Freshservice application
 type ApplicationUserDetails struct {
-	ID            int       `json:"id"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	UserID        int       `json:"user_id"`
-	LicenseID     int       `json:"license_id"`
+	ID            int       `json:"id,omitempty"` // Read-Only
+	CreatedAt     time.Time `json:"created_at"`   // Read-Only
+	UpdatedAt     time.Time `json:"updated_at"`   // Read-Only
+	UserID        int       `json:"user_id,omitempty"`
+	LicenseID     int       `json:"license_id,omitempty"`
 	AllocatedDate time.Time `json:"allocated_date"`
 	FirstUsed     time.Time `json:"first_used"`
 	LastUsed      time.Time `json:"last_used"`
-	Source        string    `json:"source"`
+	Source        string    `json:"source,omitempty"`
+}
+
+// MarshalJSON leaves the read-only timestamps and unset dates out of an application user payload
+func (ud ApplicationUserDetails) MarshalJSON() ([]byte, error) {
+	type details ApplicationUserDetails
+	return json.Marshal(struct {
+		details
+		CreatedAt     *time.Time `json:"created_at,omitempty"`
+		UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+		AllocatedDate *time.Time `json:"allocated_date,omitempty"`
+		FirstUsed     *time.Time `json:"first_used,omitempty"`
+		LastUsed      *time.Time `json:"last_used,omitempty"`
+	}{
+		details:       details(ud),
+		AllocatedDate: optionalTime(ud.AllocatedDate),
+		FirstUsed:     optionalTime(ud.FirstUsed),
+		LastUsed:      optionalTime(ud.LastUsed),
+	})
 }
 
 // ApplicationInstallations holds a list of Freshservice application installations
@@ -81,13 +129,41 @@ type ApplicationInstallations struct {
 
 // ApplicationInstallationDetails holds the details of installations for a specific Freshservice application
 type ApplicationInstallationDetails struct {
-	ID                    int       `json:"id"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
-	InstallationPath      string    `json:"installation_path"`
-	Version               string    `json:"version"`
-	InstallationMachineID int       `json:"installation_machine_id"`
-	UserID                int       `json:"user_id"`
-	DepartmentID          int       `json:"department_id"`
+	ID                    int       `json:"id,omitempty"` // Read-Only
+	CreatedAt             time.Time `json:"created_at"`   // Read-Only
+	UpdatedAt             time.Time `json:"updated_at"`   // Read-Only
+	InstallationPath      string    `json:"installation_path,omitempty"`
+	Version               string    `json:"version,omitempty"`
+	InstallationMachineID int       `json:"installation_machine_id,omitempty"` // Display ID of the asset the application is installed on
+	UserID                int       `json:"user_id,omitempty"`
+	DepartmentID          int       `json:"department_id,omitempty"`
 	InstallationDate      time.Time `json:"installation_date"`
 }
+
+// ApplicationInstallation holds the details of a specific Freshservice application installation
+type ApplicationInstallation struct {
+	Details ApplicationInstallationDetails `json:"installation"`
+}
+
+// MarshalJSON leaves the read-only timestamps and an unset installation date out of an installation payload
+func (id ApplicationInstallationDetails) MarshalJSON() ([]byte, error) {
+	type details ApplicationInstallationDetails
+	return json.Marshal(struct {
+		details
+		CreatedAt        *time.Time `json:"created_at,omitempty"`
+		UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+		InstallationDate *time.Time `json:"installation_date,omitempty"`
+	}{details: details(id), InstallationDate: optionalTime(id.InstallationDate)})
+}
+
+// optionalTime returns nil for a zero time so it is omitted from a payload.
+// Entities such as ApplicationDetails are decoded from responses and sent as
+// payloads alike. Their MarshalJSON methods shadow the fields Freshservice
+// manages with nil pointers tagged omitempty, leaving them out of the payload,
+// and use optionalTime for dates that are writable but may be left unset.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
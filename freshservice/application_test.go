@@ -0,0 +1,72 @@
+package freshservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestApplicationPayload(t *testing.T) {
+	content, err := json.Marshal(&freshservice.ApplicationDetails{Name: "Slack", ApplicationType: "saas"})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"name":"Slack","application_type":"saas"}`, string(content))
+
+	installed := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	content, err = json.Marshal(&freshservice.ApplicationInstallationDetails{InstallationMachineID: 42, Version: "4.29", InstallationDate: installed})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"installation_machine_id":42,"version":"4.29","installation_date":"2026-10-01T00:00:00Z"}`, string(content))
+}
+
+func TestApplicationInstallations(t *testing.T) {
+	var removed string
+	var perPage []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("page") == "":
+			assert.Equal(t, "/api/v2/applications/5/installations", r.URL.Path)
+			perPage = append(perPage, r.URL.Query().Get("per_page"))
+			w.Header().Set("Link", fmt.Sprintf(`<https://%s/api/v2/applications/5/installations?page=2&per_page=100>; rel="next"`, r.Host))
+			fmt.Fprint(w, `{"installations":[{"id":1,"installation_machine_id":42}]}`)
+		case r.Method == http.MethodGet:
+			fmt.Fprint(w, `{"installations":[{"id":2,"installation_machine_id":43}]}`)
+		case r.Method == http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"installation_machine_id":44}`, string(body))
+			fmt.Fprint(w, `{"installation":{"id":3,"installation_machine_id":44}}`)
+		case r.Method == http.MethodDelete:
+			removed = r.URL.Path + "?" + r.URL.RawQuery
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	first, err := c.Applications().ListInstallations(ctx, 5)
+	assert.Nil(t, err)
+	assert.Len(t, first, 1)
+
+	list, err := c.Applications().ListAllInstallations(ctx, 5, &freshservice.ApplicationListOptions{PerPage: 100}).Collect(ctx, 0)
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, 43, list[1].InstallationMachineID)
+	assert.Equal(t, []string{"", "100"}, perPage)
+
+	inst, err := c.Applications().AddInstallation(ctx, 5, &freshservice.ApplicationInstallationDetails{InstallationMachineID: 44})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, inst.ID)
+
+	assert.Nil(t, c.Applications().RemoveInstallations(ctx, 5, 42, 43))
+	assert.Equal(t, "/api/v2/applications/5/installations/remove?device_ids=42,43", removed)
+
+	removed = ""
+	assert.NotNil(t, c.Applications().RemoveInstallations(ctx, 5))
+	assert.NotNil(t, c.Applications().RemoveUsers(ctx, 5))
+	assert.Empty(t, removed)
+}
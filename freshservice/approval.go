@@ -0,0 +1,192 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const approvalURL = "/api/v2/approvals"
+
+/*
+NOTE: Approvals are requested for tickets unless accessed through a service such as
+Changes().Approvals(), in which case the ID passed in is that of the parent change.
+Approving and rejecting uses the approval ID alone and applies to any parent.
+*/
+
+// ApprovalService is an interface for interacting with
+// the approval endpoints of the Freshservice API
+type ApprovalService interface {
+	List(context.Context, int) ([]ApprovalDetails, error)
+	Request(context.Context, int, *ApprovalRequest) (*ApprovalDetails, error)
+	Get(context.Context, int, int) (*ApprovalDetails, error)
+	Cancel(context.Context, int, int) error
+	Remind(context.Context, int, int) error
+	Approve(context.Context, int, string) (*ApprovalDetails, error)
+	Reject(context.Context, int, string) (*ApprovalDetails, error)
+}
+
+// ApprovalServiceClient facilitates requests with the ApprovalService methods
+type ApprovalServiceClient struct {
+	client *Client
+	// parentURL is the endpoint the approvals are nested under, tickets when empty
+	parentURL string
+}
+
+func (c *ApprovalServiceClient) parent() string {
+	if c.parentURL == "" {
+		return ticketURL
+	}
+	return c.parentURL
+}
+
+// List the approvals requested for a given parent ID along with their status
+func (c *ApprovalServiceClient) List(ctx context.Context, parentID int) ([]ApprovalDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/approvals", c.parent(), parentID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Approvals{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// Request approval for a given parent ID from the approver set on the request
+func (c *ApprovalServiceClient) Request(ctx context.Context, parentID int, ar *ApprovalRequest) (*ApprovalDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/approvals", c.parent(), parentID),
+	}
+
+	approvalContent, err := json.Marshal(ar)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(approvalContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Approval{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific approval of a given parent ID
+func (c *ApprovalServiceClient) Get(ctx context.Context, parentID int, id int) (*ApprovalDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/approvals/%d", c.parent(), parentID, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Approval{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Cancel a pending approval of a given parent ID
+func (c *ApprovalServiceClient) Cancel(ctx context.Context, parentID int, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/approvals/%d/cancel", c.parent(), parentID, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Remind the approver of a pending approval of a given parent ID
+func (c *ApprovalServiceClient) Remind(ctx context.Context, parentID int, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/approvals/%d/remind", c.parent(), parentID, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Approve an approval as the agent the API key belongs to, recording the remark passed in
+func (c *ApprovalServiceClient) Approve(ctx context.Context, id int, remark string) (*ApprovalDetails, error) {
+	return c.decide(ctx, id, &approvalDecision{Status: "approved", Remark: remark})
+}
+
+// Reject an approval as the agent the API key belongs to, recording the remark passed in
+func (c *ApprovalServiceClient) Reject(ctx context.Context, id int, remark string) (*ApprovalDetails, error) {
+	return c.decide(ctx, id, &approvalDecision{Status: "rejected", Remark: remark})
+}
+
+// decide records the decision of the authenticated agent on an approval
+func (c *ApprovalServiceClient) decide(ctx context.Context, id int, d *approvalDecision) (*ApprovalDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", approvalURL, id),
+	}
+
+	approvalContent, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(approvalContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Approval{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
@@ -0,0 +1,65 @@
+package freshservice
+
+import "time"
+
+const (
+	// ApprovalEveryone requires every approver to approve
+	ApprovalEveryone = 1
+	// ApprovalAnyone requires any one of the approvers to approve
+	ApprovalAnyone = 2
+	// ApprovalMajority requires the majority of the approvers to approve
+	ApprovalMajority = 3
+
+	// ApprovalRequested is the status of an approval awaiting a decision
+	ApprovalRequested = 0
+	// ApprovalApproved is the status of an approval that was approved
+	ApprovalApproved = 1
+	// ApprovalRejected is the status of an approval that was rejected
+	ApprovalRejected = 2
+	// ApprovalCancelled is the status of an approval that was cancelled
+	ApprovalCancelled = 3
+)
+
+// Approvals holds a list of Freshservice approvals
+type Approvals struct {
+	List []ApprovalDetails `json:"approvals"`
+}
+
+// Approval holds the details of a specific Freshservice approval
+type Approval struct {
+	Details ApprovalDetails `json:"approval"`
+}
+
+// ApprovalDetails are the details of an approval requested from an approver
+type ApprovalDetails struct {
+	ID             int            `json:"id"`
+	ApproverID     int            `json:"approver_id"`
+	UserID         int            `json:"user_id"`
+	ApprovalType   int            `json:"approval_type"`
+	ApprovalStatus ApprovalStatus `json:"approval_status"`
+	LatestRemark   string         `json:"latest_remark"`
+	Level          int            `json:"level_id"`
+	DelegatorID    int            `json:"delegator"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// ApprovalStatus is the state of an approval, the ID being one of the Approval* statuses
+type ApprovalStatus struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ApprovalRequest is the payload to request approval from an agent or
+// requester. The EmailContent is sent to the approver along with the request.
+type ApprovalRequest struct {
+	ApproverID   int    `json:"approver_id"`
+	ApprovalType int    `json:"approval_type,omitempty"`
+	EmailContent string `json:"email_content,omitempty"`
+}
+
+// approvalDecision is the payload to approve or reject an approval
+type approvalDecision struct {
+	Status string `json:"status"`
+	Remark string `json:"remark,omitempty"`
+}
@@ -0,0 +1,46 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestApprovals(t *testing.T) {
+	var calls []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		calls = append(calls, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		switch r.URL.Path {
+		case "/api/v2/changes/12/approvals":
+			fmt.Fprint(w, `{"approval":{"id":5,"approver_id":9,"approval_type":2,"approval_status":{"id":0,"name":"requested"}}}`)
+		case "/api/v2/approvals/5":
+			fmt.Fprint(w, `{"approval":{"id":5,"approver_id":9,"approval_status":{"id":1,"name":"approved"},"latest_remark":"ok"}}`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	ap, err := c.Changes().Approvals().Request(ctx, 12, &freshservice.ApprovalRequest{ApproverID: 9, ApprovalType: freshservice.ApprovalAnyone})
+	assert.Nil(t, err)
+	assert.Equal(t, freshservice.ApprovalRequested, ap.ApprovalStatus.ID)
+
+	assert.Nil(t, c.Approvals().Remind(ctx, 301, 6))
+
+	ap, err = c.Approvals().Approve(ctx, 5, "ok")
+	assert.Nil(t, err)
+	assert.Equal(t, freshservice.ApprovalApproved, ap.ApprovalStatus.ID)
+
+	assert.Equal(t, []string{
+		`POST /api/v2/changes/12/approvals {"approver_id":9,"approval_type":2}`,
+		`PUT /api/v2/tickets/301/approvals/6/remind `,
+		`PUT /api/v2/approvals/5 {"status":"approved","remark":"ok"}`,
+	}, calls)
+}
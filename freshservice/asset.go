@@ -1,11 +1,14 @@
 package freshservice
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 const assetURL = "/api/v2/assets"
@@ -14,7 +17,23 @@ const assetURL = "/api/v2/assets"
 // the asset endpoints of the Freshservice API
 type AssetService interface {
 	List(context.Context, QueryFilter) ([]AssetDetails, string, error)
+	ListAll(context.Context, *AssetListOptions) *AssetIterator
 	Get(context.Context, int) (*AssetDetails, error)
+	Create(context.Context, *AssetDetails) (*AssetDetails, error)
+	Update(context.Context, int, *AssetDetails) (*AssetDetails, error)
+	Delete(context.Context, int) error
+	DeletePermanently(context.Context, int) error
+	Restore(context.Context, int) error
+	ListRelationships(context.Context, int) ([]RelationshipDetails, error)
+	ListComponents(context.Context, int) ([]AssetComponentDetails, error)
+	ListRequests(context.Context, int) ([]AssetRequestDetails, error)
+	ListContracts(context.Context, int) ([]AssetContractDetails, error)
+	CreateRelationships(context.Context, []RelationshipDetails) (*RelationshipJob, error)
+	GetRelationshipJob(context.Context, string) (*RelationshipJob, error)
+	WaitRelationshipJob(context.Context, string, time.Duration) (*RelationshipJob, error)
+	GetRelationships(context.Context, ...int) ([]RelationshipDetails, error)
+	DeleteRelationships(context.Context, ...int) error
+	RelationshipTypes(context.Context) ([]RelationshipTypeDetails, error)
 }
 
 // AssetServiceClient facilitates requests with the AssetService methods
@@ -24,7 +43,13 @@ type AssetServiceClient struct {
 
 // List all Assets
 // Append the parameter "page=[:page_no]" in the url to traverse through pages.
+// The search and filter queries of AssetListOptions are validated before the request is made.
 func (a *AssetServiceClient) List(ctx context.Context, filter QueryFilter) ([]AssetDetails, string, error) {
+	if opts, ok := filter.(*AssetListOptions); ok && opts != nil {
+		if err := opts.Validate(); err != nil {
+			return nil, "", err
+		}
+	}
 
 	url := &url.URL{
 		Scheme: "https",
@@ -50,6 +75,44 @@ func (a *AssetServiceClient) List(ctx context.Context, filter QueryFilter) ([]As
 	return res.List, HasNextPage(resp), nil
 }
 
+// ListAll returns an iterator over every Freshservice asset matching the
+// options passed in, requesting additional pages as the iterator advances
+func (a *AssetServiceClient) ListAll(ctx context.Context, opts *AssetListOptions) *AssetIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &AssetIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := a.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// AssetIterator iterates over a paginated list of Freshservice assets
+type AssetIterator struct {
+	pager
+	page []AssetDetails
+}
+
+// Value returns the asset the iterator currently points at
+func (it *AssetIterator) Value() AssetDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max assets.
+// A max of zero or less will collect every remaining asset.
+func (it *AssetIterator) Collect(ctx context.Context, max int) ([]AssetDetails, error) {
+	var list []AssetDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
 // Get a specific asset
 func (a *AssetServiceClient) Get(ctx context.Context, assetID int) (*AssetDetails, error) {
 
@@ -72,6 +135,124 @@ func (a *AssetServiceClient) Get(ctx context.Context, assetID int) (*AssetDetail
 	return &res.Details, nil
 }
 
+// Create a new asset
+func (a *AssetServiceClient) Create(ctx context.Context, ad *AssetDetails) (*AssetDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   assetURL,
+	}
+
+	assetContent, err := json.Marshal(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(assetContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Asset{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update an asset by its display ID
+func (a *AssetServiceClient) Update(ctx context.Context, displayID int, ad *AssetDetails) (*AssetDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", assetURL, displayID),
+	}
+
+	assetContent, err := json.Marshal(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(assetContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Asset{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete moves an asset to the trash by its display ID. Trashed assets
+// can be listed with the Trashed embed option and restored.
+func (a *AssetServiceClient) Delete(ctx context.Context, displayID int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", assetURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeletePermanently deletes an asset that has already been moved to the trash
+// Note: Permanently deleted assets can not be restored.
+func (a *AssetServiceClient) DeletePermanently(ctx context.Context, displayID int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/delete_forever", assetURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Restore an asset that has been moved to the trash by its display ID
+func (a *AssetServiceClient) Restore(ctx context.Context, displayID int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/restore", assetURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // QueryString allows us to pass AssetListOptions as a QueryFilter and
 // will return a new endpoint URL with query parameters attached
 func (opts *AssetListOptions) QueryString() string {
@@ -81,6 +262,26 @@ func (opts *AssetListOptions) QueryString() string {
 		qs = append(qs, opts.PageQuery)
 	}
 
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	if opts.Search != nil {
+		qs = append(qs, opts.Search.param("search"))
+	}
+
+	if opts.Filter != nil {
+		qs = append(qs, opts.Filter.param("filter"))
+	}
+
+	if opts.SortBy != nil {
+		if opts.SortBy.Ascending {
+			qs = append(qs, "order_type=asc")
+		} else {
+			qs = append(qs, "order_type=desc")
+		}
+	}
+
 	if opts.Embed != nil {
 		if opts.Embed.TypeFields {
 			qs = append(qs, "include=type_fields")
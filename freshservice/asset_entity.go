@@ -1,6 +1,10 @@
 package freshservice
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 // Assets holds a list of Freshservice asset details
 type Assets struct {
@@ -14,30 +18,113 @@ type Asset struct {
 
 // AssetDetails are the details related to a specific asset in Freshservice
 type AssetDetails struct {
-	ID           int       `json:"id"`
-	DisplayID    int       `json:"display_id"`
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	AssetTypeID  int       `json:"asset_type_id"`
-	Impact       string    `json:"impact"`
-	AuthorType   string    `json:"author_type"`
-	UsageType    string    `json:"usage_type"`
-	AssetTag     string    `json:"asset_tag"`
-	UserID       int64     `json:"user_id"`
-	LocationID   int64     `json:"location_id"`
-	DepartmentID int64     `json:"department_id"`
-	AgentID      int64     `json:"agent_id"`
-	AssignedOn   time.Time `json:"assigned_on"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           int             `json:"id,omitempty"`         // Read-Only
+	DisplayID    int             `json:"display_id,omitempty"` // Read-Only
+	Name         string          `json:"name,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	AssetTypeID  int             `json:"asset_type_id,omitempty"`
+	Impact       string          `json:"impact,omitempty"`
+	AuthorType   string          `json:"author_type,omitempty"` // Read-Only
+	UsageType    string          `json:"usage_type,omitempty"`
+	AssetTag     string          `json:"asset_tag,omitempty"`
+	UserID       int64           `json:"user_id,omitempty"`
+	LocationID   int64           `json:"location_id,omitempty"`
+	DepartmentID int64           `json:"department_id,omitempty"`
+	AgentID      int64           `json:"agent_id,omitempty"`
+	GroupID      int64           `json:"group_id,omitempty"`
+	AssignedOn   time.Time       `json:"assigned_on"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	TypeFields   AssetTypeFields `json:"type_fields,omitempty"`
+}
+
+// MarshalJSON leaves the read-only timestamps and an unset assignment date out of an asset payload
+func (ad AssetDetails) MarshalJSON() ([]byte, error) {
+	type details AssetDetails
+	return json.Marshal(struct {
+		details
+		AssignedOn *time.Time `json:"assigned_on,omitempty"`
+		CreatedAt  *time.Time `json:"created_at,omitempty"`
+		UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	}{details: details(ad), AssignedOn: optionalTime(ad.AssignedOn)})
+}
+
+// AssetTypeFields holds the fields specific to the type of an asset. Freshservice
+// suffixes each field name with the ID of the asset type it belongs to, e.g.
+// serial_number_4000123456, which the Get and Set helpers take care of.
+type AssetTypeFields map[string]interface{}
+
+// Get returns the value of a type field for the asset type ID passed in
+func (tf AssetTypeFields) Get(name string, assetTypeID int) (interface{}, bool) {
+	v, ok := tf[typeFieldKey(name, assetTypeID)]
+	return v, ok
+}
+
+// Set sets the value of a type field for the asset type ID passed in
+func (tf AssetTypeFields) Set(name string, assetTypeID int, value interface{}) {
+	tf[typeFieldKey(name, assetTypeID)] = value
+}
+
+func typeFieldKey(name string, assetTypeID int) string {
+	return fmt.Sprintf("%s_%d", name, assetTypeID)
+}
+
+// AssetReference references an asset associated with a change, problem or release by its display ID
+type AssetReference struct {
+	DisplayID int `json:"display_id"`
 }
 
 // AssetListOptions holds the available options that can be
 // passed when requesting a list of Freshservice assets
 type AssetListOptions struct {
 	PageQuery string
-	SortBy    *SortOptions
-	Embed     *AssetEmbedOptions
+	PerPage   int
+	// Search matches assets by name, asset_tag or serial_number
+	Search *Query
+	// Filter matches assets by any of the AssetFilterFields
+	Filter *Query
+	SortBy *SortOptions
+	Embed  *AssetEmbedOptions
+}
+
+// AssetSearchFields are the fields an asset list can be searched by
+var AssetSearchFields = []string{"name", "asset_tag", "serial_number"}
+
+// AssetFilterFields are the fields an asset list can be filtered by
+var AssetFilterFields = []string{
+	"asset_type_id",
+	"department_id",
+	"location_id",
+	"asset_state",
+	"user_id",
+	"agent_id",
+	"name",
+	"asset_tag",
+	"created_at",
+	"updated_at",
+}
+
+// Validate will confirm that the search and filter queries only reference supported fields
+func (opts *AssetListOptions) Validate() error {
+	if opts.Search != nil {
+		if err := opts.Search.Validate(); err != nil {
+			return err
+		}
+		if err := opts.Search.validateFields(AssetSearchFields...); err != nil {
+			return err
+		}
+	}
+
+	if opts.Filter != nil {
+		if err := opts.Filter.Validate(); err != nil {
+			return err
+		}
+		if err := opts.Filter.validateFields(AssetFilterFields...); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // AssetEmbedOptions will optonally embed desired metadata in an asset list response
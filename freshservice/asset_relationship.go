@@ -0,0 +1,262 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	relationshipURL     = "/api/v2/relationships"
+	relationshipTypeURL = "/api/v2/relationship_types"
+	jobURL              = "/api/v2/jobs"
+)
+
+// errNoIDs is returned by the bulk endpoints taking a list of IDs when none are passed in
+var errNoIDs = errors.New("at least one ID is required")
+
+// ListRelationships lists the relationships of an asset with other assets and entities
+func (a *AssetServiceClient) ListRelationships(ctx context.Context, displayID int) ([]RelationshipDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/relationships", assetURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Relationships{}
+	if _, err = a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// ListComponents lists the hardware components of an asset
+func (a *AssetServiceClient) ListComponents(ctx context.Context, displayID int) ([]AssetComponentDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/components", assetURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AssetComponents{}
+	if _, err = a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// ListRequests lists the tickets, problems, changes and releases associated with an asset
+func (a *AssetServiceClient) ListRequests(ctx context.Context, displayID int) ([]AssetRequestDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/requests", assetURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AssetRequests{}
+	if _, err = a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// ListContracts lists the contracts associated with an asset
+func (a *AssetServiceClient) ListContracts(ctx context.Context, displayID int) ([]AssetContractDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/contracts", assetURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AssetContracts{}
+	if _, err = a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// CreateRelationships creates relationships in bulk. Freshservice processes the
+// relationships asynchronously, use GetRelationshipJob or WaitRelationshipJob with
+// the returned job to find out which relationships were created.
+func (a *AssetServiceClient) CreateRelationships(ctx context.Context, relationships []RelationshipDetails) (*RelationshipJob, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   relationshipURL + "/bulk-create",
+	}
+
+	relationshipContent, err := json.Marshal(&Relationships{List: relationships})
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(relationshipContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RelationshipJob{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetRelationshipJob returns the status of a bulk relationship creation job
+func (a *AssetServiceClient) GetRelationshipJob(ctx context.Context, jobID string) (*RelationshipJob, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   fmt.Sprintf("%s/%s", jobURL, jobID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RelationshipJob{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// WaitRelationshipJob polls a bulk relationship creation job at the interval
+// passed in until it is done or the context is cancelled. The interval must be positive.
+func (a *AssetServiceClient) WaitRelationshipJob(ctx context.Context, jobID string, interval time.Duration) (*RelationshipJob, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("relationship job poll interval must be positive, got %s", interval)
+	}
+
+	for {
+		job, err := a.GetRelationshipJob(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		if job.Done() {
+			return job, nil
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return job, err
+		}
+	}
+}
+
+// GetRelationships returns the relationships matching the IDs passed in
+func (a *AssetServiceClient) GetRelationships(ctx context.Context, ids ...int) ([]RelationshipDetails, error) {
+	if len(ids) == 0 {
+		return nil, errNoIDs
+	}
+
+	url := &url.URL{
+		Scheme:   "https",
+		Host:     a.client.Domain,
+		Path:     relationshipURL,
+		RawQuery: "ids=" + joinIDs(ids),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Relationships{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// DeleteRelationships deletes the relationships matching the IDs passed in
+func (a *AssetServiceClient) DeleteRelationships(ctx context.Context, ids ...int) error {
+	if len(ids) == 0 {
+		return errNoIDs
+	}
+
+	url := &url.URL{
+		Scheme:   "https",
+		Host:     a.client.Domain,
+		Path:     relationshipURL,
+		RawQuery: "ids=" + joinIDs(ids),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RelationshipTypes lists the types of relationships configured in Freshservice
+func (a *AssetServiceClient) RelationshipTypes(ctx context.Context) ([]RelationshipTypeDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   a.client.Domain,
+		Path:   relationshipTypeURL,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RelationshipTypes{}
+	if _, err := a.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// joinIDs returns the IDs passed in as a comma separated list
+func joinIDs(ids []int) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.Itoa(id)
+	}
+	return strings.Join(s, ",")
+}
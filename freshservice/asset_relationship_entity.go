@@ -0,0 +1,118 @@
+package freshservice
+
+import "time"
+
+const (
+	// RelationshipJobQueued indicates a bulk relationship job has not started
+	RelationshipJobQueued = "queued"
+	// RelationshipJobInProgress indicates a bulk relationship job is running
+	RelationshipJobInProgress = "in progress"
+	// RelationshipJobSuccess indicates every relationship of a bulk job was created
+	RelationshipJobSuccess = "success"
+	// RelationshipJobPartial indicates only some relationships of a bulk job were created
+	RelationshipJobPartial = "partial"
+	// RelationshipJobFailed indicates no relationships of a bulk job were created
+	RelationshipJobFailed = "failed"
+)
+
+// Relationships holds a list of Freshservice relationships
+type Relationships struct {
+	List []RelationshipDetails `json:"relationships"`
+}
+
+// RelationshipDetails describes a relationship between two Freshservice entities, e.g. an
+// asset that depends on another. The primary and secondary types are "asset", "requester",
+// "agent", "department" or "software".
+type RelationshipDetails struct {
+	ID                 int        `json:"id,omitempty"` // Read-Only
+	RelationshipTypeID int        `json:"relationship_type_id"`
+	PrimaryID          int        `json:"primary_id"`
+	PrimaryType        string     `json:"primary_type"`
+	SecondaryID        int        `json:"secondary_id"`
+	SecondaryType      string     `json:"secondary_type"`
+	Success            *bool      `json:"success,omitempty"`    // Read-Only, set on bulk job results
+	Errors             []Error    `json:"errors,omitempty"`     // Read-Only, set on bulk job results
+	CreatedAt          *time.Time `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"` // Read-Only
+}
+
+// RelationshipJob holds the status of a bulk relationship creation job
+type RelationshipJob struct {
+	JobID         string                `json:"job_id"`
+	Status        string                `json:"status"`
+	Href          string                `json:"href"`
+	Relationships []RelationshipDetails `json:"relationships"`
+	CreatedAt     time.Time             `json:"created_at"`
+	UpdatedAt     time.Time             `json:"updated_at"`
+}
+
+// Done reports whether the job has finished, successfully or not. A job
+// whose status is missing or unknown is not considered done.
+func (j *RelationshipJob) Done() bool {
+	switch j.Status {
+	case RelationshipJobSuccess, RelationshipJobPartial, RelationshipJobFailed:
+		return true
+	}
+	return false
+}
+
+// RelationshipTypes holds a list of Freshservice relationship types
+type RelationshipTypes struct {
+	List []RelationshipTypeDetails `json:"relationship_types"`
+}
+
+// RelationshipTypeDetails describes a type of relationship such as "Depends On / Used By"
+type RelationshipTypeDetails struct {
+	ID                 int       `json:"id"`
+	Description        string    `json:"description"`
+	DownstreamRelation string    `json:"downstream_relation"`
+	UpstreamRelation   string    `json:"upstream_relation"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// AssetComponents holds a list of components of a Freshservice asset
+type AssetComponents struct {
+	List []AssetComponentDetails `json:"components"`
+}
+
+// AssetComponentDetails describes a hardware component of an asset, e.g. a processor
+// or memory module. The component data depends on the component type.
+type AssetComponentDetails struct {
+	ID            int                    `json:"id"`
+	ComponentType string                 `json:"component_type"`
+	ComponentData map[string]interface{} `json:"component_data"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+// AssetRequests holds a list of requests associated with a Freshservice asset
+type AssetRequests struct {
+	List []AssetRequestDetails `json:"requests"`
+}
+
+// AssetRequestDetails describes a ticket, problem, change or release associated with an asset
+type AssetRequestDetails struct {
+	RequestID      string    `json:"request_id"`
+	RequestType    string    `json:"request_type"`
+	RequestStatus  string    `json:"request_status"`
+	RequestDetails string    `json:"request_details"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// AssetContracts holds a list of contracts associated with a Freshservice asset
+type AssetContracts struct {
+	List []AssetContractDetails `json:"contracts"`
+}
+
+// AssetContractDetails describes a contract, e.g. a lease or warranty, covering an asset
+type AssetContractDetails struct {
+	ID             int       `json:"id"`
+	ContractID     string    `json:"contract_id"`
+	ContractName   string    `json:"contract_name"`
+	ContractType   string    `json:"contract_type"`
+	ContractStatus string    `json:"contract_status"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
@@ -0,0 +1,182 @@
+package freshservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestAssetDetailsPayload(t *testing.T) {
+	ad := &freshservice.AssetDetails{
+		Name:        "laptop-0042",
+		AssetTypeID: 4000123456,
+		TypeFields:  freshservice.AssetTypeFields{},
+	}
+	ad.TypeFields.Set("serial_number", ad.AssetTypeID, "C02XK0AAJGH5")
+
+	content, err := json.Marshal(ad)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"name": "laptop-0042",
+		"asset_type_id": 4000123456,
+		"type_fields": {"serial_number_4000123456": "C02XK0AAJGH5"}
+	}`, string(content))
+
+	res := &freshservice.Asset{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"asset":{"display_id":7,"created_at":"2026-10-18T10:00:00Z","type_fields":{"serial_number_4000123456":"C02XK0AAJGH5"}}}`), res))
+	assert.Equal(t, 7, res.Details.DisplayID)
+	assert.Equal(t, 2026, res.Details.CreatedAt.Year())
+
+	serial, ok := res.Details.TypeFields.Get("serial_number", 4000123456)
+	assert.True(t, ok)
+	assert.Equal(t, "C02XK0AAJGH5", serial)
+}
+
+func TestAssetLifecycle(t *testing.T) {
+	var calls []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		if r.Method == http.MethodPost {
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"name":"laptop-0042","asset_type_id":1}`, string(body))
+			fmt.Fprint(w, `{"asset":{"display_id":7,"name":"laptop-0042"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	ad, err := c.Assets().Create(ctx, &freshservice.AssetDetails{Name: "laptop-0042", AssetTypeID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 7, ad.DisplayID)
+
+	assert.Nil(t, c.Assets().Delete(ctx, 7))
+	assert.Nil(t, c.Assets().Restore(ctx, 7))
+	assert.Nil(t, c.Assets().Delete(ctx, 7))
+	assert.Nil(t, c.Assets().DeletePermanently(ctx, 7))
+
+	assert.Equal(t, []string{
+		"POST /api/v2/assets",
+		"DELETE /api/v2/assets/7",
+		"PUT /api/v2/assets/7/restore",
+		"DELETE /api/v2/assets/7",
+		"PUT /api/v2/assets/7/delete_forever",
+	}, calls)
+}
+
+func TestAssetListSearchAndFilter(t *testing.T) {
+	opts := &freshservice.AssetListOptions{
+		Search: freshservice.Q().Eq("serial_number", "C02XK0AAJGH5"),
+		Filter: freshservice.Q().Eq("asset_state", "In Use").Eq("department_id", 5),
+		SortBy: &freshservice.SortOptions{Ascending: true},
+	}
+	assert.Nil(t, opts.Validate())
+	assert.Equal(t, "search=%22serial_number%3A%27C02XK0AAJGH5%27%22&"+
+		"filter=%22asset_state%3A%27In%20Use%27%20AND%20department_id%3A5%22&order_type=asc", opts.QueryString())
+
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `"serial_number:'C02XK0AAJGH5'"`, r.URL.Query().Get("search"))
+		fmt.Fprint(w, `{"assets":[{"display_id":7}]}`)
+	})
+	defer srv.Close()
+
+	list, _, err := c.Assets().List(context.Background(), &freshservice.AssetListOptions{Search: opts.Search})
+	assert.Nil(t, err)
+	assert.Len(t, list, 1)
+
+	_, _, err = c.Assets().List(context.Background(), &freshservice.AssetListOptions{
+		Search: freshservice.Q().Eq("asset_type_id", 1),
+	})
+	assert.NotNil(t, err)
+}
+
+func TestAssetRelationshipJob(t *testing.T) {
+	polls := 0
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/relationships/bulk-create":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"relationships":[{"relationship_type_id":3,"primary_id":7,"primary_type":"asset","secondary_id":9,"secondary_type":"asset"}]}`, string(body))
+			fmt.Fprint(w, `{"job_id":"abc","href":"/api/v2/jobs/abc"}`)
+		case "/api/v2/jobs/abc":
+			polls++
+			if polls < 2 {
+				fmt.Fprint(w, `{"job_id":"abc","status":"in progress"}`)
+				return
+			}
+			fmt.Fprint(w, `{"job_id":"abc","status":"success","relationships":[{"id":11,"relationship_type_id":3,"primary_id":7,"primary_type":"asset","secondary_id":9,"secondary_type":"asset","success":true}]}`)
+		case "/api/v2/assets/7/relationships":
+			fmt.Fprint(w, `{"relationships":[{"id":11,"relationship_type_id":3,"primary_id":7,"primary_type":"asset","secondary_id":9,"secondary_type":"asset"}]}`)
+		case "/api/v2/relationships":
+			assert.Equal(t, "ids=11,12", r.URL.RawQuery)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	job, err := c.Assets().CreateRelationships(ctx, []freshservice.RelationshipDetails{
+		{RelationshipTypeID: 3, PrimaryID: 7, PrimaryType: "asset", SecondaryID: 9, SecondaryType: "asset"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", job.JobID)
+
+	job, err = c.Assets().WaitRelationshipJob(ctx, job.JobID, time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, freshservice.RelationshipJobSuccess, job.Status)
+	assert.Len(t, job.Relationships, 1)
+
+	list, err := c.Assets().ListRelationships(ctx, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, 9, list[0].SecondaryID)
+
+	assert.Nil(t, c.Assets().DeleteRelationships(ctx, 11, 12))
+}
+
+func TestAssetRelationshipJobDone(t *testing.T) {
+	for status, done := range map[string]bool{
+		"":                                     false,
+		"unknown":                              false,
+		freshservice.RelationshipJobQueued:     false,
+		freshservice.RelationshipJobInProgress: false,
+		freshservice.RelationshipJobSuccess:    true,
+		freshservice.RelationshipJobPartial:    true,
+		freshservice.RelationshipJobFailed:     true,
+	} {
+		job := &freshservice.RelationshipJob{Status: status}
+		assert.Equal(t, done, job.Done(), status)
+	}
+}
+
+func TestAssetWaitRelationshipJobInterval(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	defer srv.Close()
+
+	_, err := c.Assets().WaitRelationshipJob(context.Background(), "abc", 0)
+	assert.NotNil(t, err)
+}
+
+func TestAssetRelationshipsRequireIDs(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	_, err := c.Assets().GetRelationships(ctx)
+	assert.NotNil(t, err)
+	assert.NotNil(t, c.Assets().DeleteRelationships(ctx, []int{}...))
+}
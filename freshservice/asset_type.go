@@ -0,0 +1,227 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const assetTypeURL = "/api/v2/asset_types"
+
+// AssetTypeService is an interface for interacting with
+// the asset type endpoints of the Freshservice API
+type AssetTypeService interface {
+	List(context.Context, QueryFilter) ([]AssetTypeDetails, string, error)
+	ListAll(context.Context, *AssetTypeListOptions) *AssetTypeIterator
+	Create(context.Context, *AssetTypeDetails) (*AssetTypeDetails, error)
+	Get(context.Context, int) (*AssetTypeDetails, error)
+	Update(context.Context, int, *AssetTypeDetails) (*AssetTypeDetails, error)
+	Delete(context.Context, int) error
+	Children(context.Context, int) ([]AssetTypeDetails, error)
+	Fields(context.Context, int) (*AssetTypeFieldGroups, error)
+}
+
+// AssetTypeServiceClient facilitates requests with the AssetTypeService methods
+type AssetTypeServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice asset types
+func (at *AssetTypeServiceClient) List(ctx context.Context, filter QueryFilter) ([]AssetTypeDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   at.client.Domain,
+		Path:   assetTypeURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &AssetTypes{}
+	resp, err := at.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice asset type matching the
+// options passed in, requesting additional pages as the iterator advances
+func (at *AssetTypeServiceClient) ListAll(ctx context.Context, opts *AssetTypeListOptions) *AssetTypeIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &AssetTypeIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := at.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// AssetTypeIterator iterates over a paginated list of Freshservice asset types
+type AssetTypeIterator struct {
+	pager
+	page []AssetTypeDetails
+}
+
+// Value returns the asset type the iterator currently points at
+func (it *AssetTypeIterator) Value() AssetTypeDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max asset types.
+// A max of zero or less will collect every remaining asset type.
+func (it *AssetTypeIterator) Collect(ctx context.Context, max int) ([]AssetTypeDetails, error) {
+	var list []AssetTypeDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Create a new Freshservice asset type. Set the ParentAssetTypeID
+// to place it under an existing asset type.
+func (at *AssetTypeServiceClient) Create(ctx context.Context, atd *AssetTypeDetails) (*AssetTypeDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   at.client.Domain,
+		Path:   assetTypeURL,
+	}
+
+	assetTypeContent, err := json.Marshal(atd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(assetTypeContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AssetType{}
+	if _, err := at.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific Freshservice asset type
+func (at *AssetTypeServiceClient) Get(ctx context.Context, id int) (*AssetTypeDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   at.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", assetTypeURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AssetType{}
+	if _, err := at.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice asset type
+func (at *AssetTypeServiceClient) Update(ctx context.Context, id int, atd *AssetTypeDetails) (*AssetTypeDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   at.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", assetTypeURL, id),
+	}
+
+	assetTypeContent, err := json.Marshal(atd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(assetTypeContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AssetType{}
+	if _, err := at.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice asset type
+// Note: Asset types with assets or child asset types can not be deleted.
+func (at *AssetTypeServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   at.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", assetTypeURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := at.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Children returns the asset types whose parent is the asset type ID passed in
+func (at *AssetTypeServiceClient) Children(ctx context.Context, id int) ([]AssetTypeDetails, error) {
+	var children []AssetTypeDetails
+	iter := at.ListAll(ctx, &AssetTypeListOptions{PerPage: MaxPerPage})
+	for iter.Next(ctx) {
+		if iter.Value().ParentAssetTypeID == id {
+			children = append(children, iter.Value())
+		}
+	}
+	return children, iter.Err()
+}
+
+// Fields returns the field definitions of an asset type, including the
+// fields inherited from its parent asset types
+func (at *AssetTypeServiceClient) Fields(ctx context.Context, id int) (*AssetTypeFieldGroups, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   at.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/fields", assetTypeURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AssetTypeFieldGroups{}
+	if _, err := at.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
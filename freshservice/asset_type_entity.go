@@ -0,0 +1,192 @@
+package freshservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// AssetFieldText is a single line text asset type field
+	AssetFieldText = "text"
+	// AssetFieldParagraph is a multi line text asset type field
+	AssetFieldParagraph = "paragraph"
+	// AssetFieldNumber is an integer asset type field
+	AssetFieldNumber = "number"
+	// AssetFieldDecimal is a decimal asset type field
+	AssetFieldDecimal = "decimal"
+	// AssetFieldDate is a date asset type field
+	AssetFieldDate = "date"
+	// AssetFieldCheckbox is a boolean asset type field
+	AssetFieldCheckbox = "checkbox"
+	// AssetFieldDropdown is an asset type field restricted to its Choices
+	AssetFieldDropdown = "dropdown"
+	// AssetFieldLookup is an asset type field referencing another entity, e.g. a vendor
+	AssetFieldLookup = "lookup"
+)
+
+// AssetTypes holds a list of Freshservice asset types
+type AssetTypes struct {
+	List []AssetTypeDetails `json:"asset_types"`
+}
+
+// AssetType holds the details of a specific Freshservice asset type
+type AssetType struct {
+	Details AssetTypeDetails `json:"asset_type"`
+}
+
+// AssetTypeDetails are the details related to a specific asset type.
+// Asset types form a hierarchy through the ParentAssetTypeID, e.g.
+// Laptop is a child of Computer which is a child of Hardware.
+type AssetTypeDetails struct {
+	ID                int        `json:"id,omitempty"` // Read-Only
+	Name              string     `json:"name,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	ParentAssetTypeID int        `json:"parent_asset_type_id,omitempty"`
+	Visible           *bool      `json:"visible,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"` // Read-Only
+}
+
+// AssetTypeListOptions holds the available options that can be
+// passed when requesting a list of Freshservice asset types
+type AssetTypeListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *AssetTypeListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
+
+// AssetTypeFieldGroups holds the field definitions of an asset type grouped
+// under the headers they are shown with in Freshservice
+type AssetTypeFieldGroups struct {
+	List []AssetTypeFieldGroup `json:"asset_type_fields"`
+}
+
+// AssetTypeFieldGroup is a group of asset type fields, e.g. "General" or "Hardware"
+type AssetTypeFieldGroup struct {
+	ID          int                     `json:"id"`
+	FieldHeader string                  `json:"field_header"`
+	Fields      []AssetTypeFieldDetails `json:"fields"`
+}
+
+// AssetTypeFieldDetails is the definition of a single field of an asset type.
+// Default fields are set on AssetDetails directly while the others belong in
+// the TypeFields of an asset.
+type AssetTypeFieldDetails struct {
+	ID           int                    `json:"id"`
+	AssetTypeID  int                    `json:"asset_type_id"`
+	Name         string                 `json:"name"`
+	Label        string                 `json:"label"`
+	Description  string                 `json:"desc"`
+	FieldType    string                 `json:"field_type"`
+	Required     bool                   `json:"required"`
+	DefaultField bool                   `json:"default_field"`
+	Choices      []AssetTypeFieldChoice `json:"choices"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+// Key returns the key of the field within AssetTypeFields, which is
+// the field name suffixed with the ID of the asset type it belongs to
+func (fd *AssetTypeFieldDetails) Key() string {
+	if fd.AssetTypeID == 0 || strings.HasSuffix(fd.Name, fmt.Sprintf("_%d", fd.AssetTypeID)) {
+		return fd.Name
+	}
+	return typeFieldKey(fd.Name, fd.AssetTypeID)
+}
+
+// AssetTypeFieldChoice is one of the values allowed by a dropdown field
+type AssetTypeFieldChoice struct {
+	ID    int
+	Value string
+}
+
+// UnmarshalJSON decodes a choice which Freshservice sends as a [value, id] pair
+func (c *AssetTypeFieldChoice) UnmarshalJSON(b []byte) error {
+	var pair []interface{}
+	if err := json.Unmarshal(b, &pair); err != nil {
+		return err
+	}
+
+	if len(pair) > 0 {
+		c.Value = fmt.Sprint(pair[0])
+	}
+
+	if len(pair) > 1 {
+		switch id := pair[1].(type) {
+		case float64:
+			c.ID = int(id)
+		case string:
+			c.ID, _ = strconv.Atoi(id)
+		}
+	}
+
+	return nil
+}
+
+// Fields returns the field definitions of every group
+func (g *AssetTypeFieldGroups) Fields() []AssetTypeFieldDetails {
+	var fields []AssetTypeFieldDetails
+	for _, group := range g.List {
+		fields = append(fields, group.Fields...)
+	}
+	return fields
+}
+
+// ValidateTypeFields checks the type fields of an asset payload against the field
+// definitions of its asset type. Unknown fields, missing required fields and
+// dropdown values that are not one of the choices are reported.
+func ValidateTypeFields(fields []AssetTypeFieldDetails, tf AssetTypeFields) error {
+	known := map[string]AssetTypeFieldDetails{}
+	for _, fd := range fields {
+		if !fd.DefaultField {
+			known[fd.Key()] = fd
+		}
+	}
+
+	var problems []string
+	for _, fd := range known {
+		if v, ok := tf[fd.Key()]; fd.Required && (!ok || v == nil || v == "") {
+			problems = append(problems, fmt.Sprintf("%s is required", fd.Key()))
+		}
+	}
+
+	for k, v := range tf {
+		fd, ok := known[k]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is not a field of the asset type", k))
+			continue
+		}
+
+		if fd.FieldType == AssetFieldDropdown && v != nil && !fd.hasChoice(v) {
+			problems = append(problems, fmt.Sprintf("%s does not allow the value %v", k, v))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid type fields: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func (fd *AssetTypeFieldDetails) hasChoice(v interface{}) bool {
+	return choiceIndex(len(fd.Choices), func(i int) string { return fd.Choices[i].Value }, v) >= 0
+}
@@ -0,0 +1,48 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestAssetTypeFields(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/asset_types/25/fields", r.URL.Path)
+		fmt.Fprint(w, `{"asset_type_fields":[
+			{"id":1,"field_header":"General","fields":[
+				{"id":10,"asset_type_id":null,"name":"name","label":"Display Name","field_type":"text","required":true,"default_field":true}
+			]},
+			{"id":2,"field_header":"Hardware","fields":[
+				{"id":11,"asset_type_id":25,"name":"product_25","label":"Product","field_type":"lookup","required":true,"default_field":false},
+				{"id":12,"asset_type_id":25,"name":"state","label":"State","field_type":"dropdown","required":false,"default_field":false,"choices":[["In Use",1],["In Stock",2]]}
+			]}
+		]}`)
+	})
+	defer srv.Close()
+
+	groups, err := c.AssetTypes().Fields(context.Background(), 25)
+	assert.Nil(t, err)
+	assert.Len(t, groups.List, 2)
+
+	fields := groups.Fields()
+	assert.Len(t, fields, 3)
+	assert.Equal(t, "product_25", fields[1].Key())
+	assert.Equal(t, "state_25", fields[2].Key())
+	assert.Equal(t, freshservice.AssetTypeFieldChoice{ID: 2, Value: "In Stock"}, fields[2].Choices[1])
+
+	tf := freshservice.AssetTypeFields{}
+	tf.Set("state", 25, "In Use")
+	tf.Set("product", 25, 7)
+	assert.Nil(t, freshservice.ValidateTypeFields(fields, tf))
+
+	tf.Set("state", 25, "Lost")
+	tf.Set("colour", 25, "red")
+	delete(tf, "product_25")
+	assert.EqualError(t, freshservice.ValidateTypeFields(fields, tf),
+		"invalid type fields: colour_25 is not a field of the asset type; product_25 is required; state_25 does not allow the value Lost")
+}
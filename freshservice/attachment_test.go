@@ -0,0 +1,83 @@
+package freshservice_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func attachmentServer(t *testing.T) (*freshservice.Client, string, func()) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/attachments/5":
+			http.Redirect(w, r, "/signed/evidence.log?signature=abc", http.StatusFound)
+		case "/signed/evidence.log":
+			fmt.Fprint(w, "evidence")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return c, srv.URL, srv.Close
+}
+
+func TestDownloadAttachmentByID(t *testing.T) {
+	c, _, done := attachmentServer(t)
+	defer done()
+
+	buf := &bytes.Buffer{}
+	n, err := c.DownloadAttachmentByID(context.Background(), 5, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Equal(t, "evidence", buf.String())
+}
+
+func TestDownloadAttachmentSizeMismatch(t *testing.T) {
+	c, srvURL, done := attachmentServer(t)
+	defer done()
+
+	att := freshservice.Attachment{
+		Name:          "evidence.log",
+		Size:          8,
+		AttachmentURL: srvURL + "/api/v2/attachments/5",
+	}
+
+	buf := &bytes.Buffer{}
+	_, err := c.DownloadAttachment(context.Background(), att, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "evidence", buf.String())
+
+	att.Size = 100
+	_, err = c.DownloadAttachment(context.Background(), att, &bytes.Buffer{})
+	assert.NotNil(t, err)
+}
+
+func TestDownloadAttachmentForeignHost(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Header().Set("X-Ratelimit-Total", "100")
+		w.Header().Set("X-Ratelimit-Remaining", "1")
+		fmt.Fprint(w, "evidence")
+	}))
+	defer storage.Close()
+
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to the Freshservice domain %s", r.URL.Path)
+	})
+	defer srv.Close()
+
+	before := c.RateLimit()
+	att := freshservice.Attachment{Name: "evidence.log", Size: 8, AttachmentURL: storage.URL + "/signed/evidence.log?X-Amz-Signature=abc"}
+
+	buf := &bytes.Buffer{}
+	n, err := c.DownloadAttachment(context.Background(), att, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Equal(t, "evidence", buf.String())
+	assert.Equal(t, before, c.RateLimit())
+}
@@ -0,0 +1,213 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const changeURL = "/api/v2/changes"
+
+// ChangeService is an interface for interacting with
+// the change endpoints of the Freshservice API
+type ChangeService interface {
+	List(context.Context, QueryFilter) ([]ChangeDetails, string, error)
+	ListAll(context.Context, *ChangeListOptions) *ChangeIterator
+	Create(context.Context, *ChangeDetails) (*ChangeDetails, error)
+	Get(context.Context, int) (*ChangeDetails, error)
+	Update(context.Context, int, *ChangeDetails) (*ChangeDetails, error)
+	Delete(context.Context, int) error
+	Notes() NoteService
+	Tasks() TaskService
+	TimeEntries() TimeEntryService
+	Approvals() ApprovalService
+}
+
+// ChangeServiceClient facilitates requests with the ChangeService methods
+type ChangeServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice changes
+func (c *ChangeServiceClient) List(ctx context.Context, filter QueryFilter) ([]ChangeDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   changeURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Changes{}
+	resp, err := c.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice change matching the
+// options passed in, requesting additional pages as the iterator advances
+func (c *ChangeServiceClient) ListAll(ctx context.Context, opts *ChangeListOptions) *ChangeIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &ChangeIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := c.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// ChangeIterator iterates over a paginated list of Freshservice changes
+type ChangeIterator struct {
+	pager
+	page []ChangeDetails
+}
+
+// Value returns the change the iterator currently points at
+func (it *ChangeIterator) Value() ChangeDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max changes.
+// A max of zero or less will collect every remaining change.
+func (it *ChangeIterator) Collect(ctx context.Context, max int) ([]ChangeDetails, error) {
+	var list []ChangeDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Create a new Freshservice change
+func (c *ChangeServiceClient) Create(ctx context.Context, cd *ChangeDetails) (*ChangeDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   changeURL,
+	}
+
+	changeContent, err := json.Marshal(cd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(changeContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Change{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific Freshservice change
+func (c *ChangeServiceClient) Get(ctx context.Context, id int) (*ChangeDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", changeURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Change{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice change. Set the Status to ChangeClosed to close the change.
+func (c *ChangeServiceClient) Update(ctx context.Context, id int, cd *ChangeDetails) (*ChangeDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", changeURL, id),
+	}
+
+	changeContent, err := json.Marshal(cd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(changeContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Change{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice change
+func (c *ChangeServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", changeURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Notes is the interface to the notes of a Freshservice change
+func (c *ChangeServiceClient) Notes() NoteService {
+	return &NoteServiceClient{client: c.client, parentURL: changeURL}
+}
+
+// Tasks is the interface to the tasks of a Freshservice change
+func (c *ChangeServiceClient) Tasks() TaskService {
+	return &TaskServiceClient{client: c.client, parentURL: changeURL}
+}
+
+// TimeEntries is the interface to the time entries of a Freshservice change
+func (c *ChangeServiceClient) TimeEntries() TimeEntryService {
+	return &TimeEntryServiceClient{client: c.client, parentURL: changeURL}
+}
+
+// Approvals is the interface to the approvals of a Freshservice change
+func (c *ChangeServiceClient) Approvals() ApprovalService {
+	return &ApprovalServiceClient{client: c.client, parentURL: changeURL}
+}
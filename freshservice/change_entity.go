@@ -0,0 +1,140 @@
+package freshservice
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ChangeOpen is the value required to indicate a change status is open
+	ChangeOpen = 1
+	// ChangePlanning is the value required to indicate a change is being planned
+	ChangePlanning = 2
+	// ChangeAwaitingApproval is the value required to indicate a change is awaiting approval
+	ChangeAwaitingApproval = 3
+	// ChangePendingRelease is the value required to indicate a change is pending release
+	ChangePendingRelease = 4
+	// ChangePendingReview is the value required to indicate a change is pending review
+	ChangePendingReview = 5
+	// ChangeClosed is the value required to indicate a change status is closed
+	ChangeClosed = 6
+	// ChangeTypeMinor is the value to set a change type to minor
+	ChangeTypeMinor = 1
+	// ChangeTypeStandard is the value to set a change type to standard
+	ChangeTypeStandard = 2
+	// ChangeTypeMajor is the value to set a change type to major
+	ChangeTypeMajor = 3
+	// ChangeTypeEmergency is the value to set a change type to emergency
+	ChangeTypeEmergency = 4
+	// LowRisk is the value to set a change risk to low
+	LowRisk = 1
+	// MediumRisk is the value to set a change risk to medium
+	MediumRisk = 2
+	// HighRisk is the value to set a change risk to high
+	HighRisk = 3
+	// VeryHighRisk is the value to set a change risk to very high
+	VeryHighRisk = 4
+	// LowImpact is the value to set an impact to low
+	LowImpact = 1
+	// MediumImpact is the value to set an impact to medium
+	MediumImpact = 2
+	// HighImpact is the value to set an impact to high
+	HighImpact = 3
+)
+
+// Changes holds a list of Freshservice changes
+type Changes struct {
+	List []ChangeDetails `json:"changes"`
+}
+
+// Change holds the details of a specific Freshservice change
+type Change struct {
+	Details ChangeDetails `json:"change"`
+}
+
+// ChangeDetails contains the details of a specific Freshservice change
+type ChangeDetails struct {
+	ID               int                   `json:"id,omitempty"` // Read-Only
+	RequesterID      int                   `json:"requester_id,omitempty"`
+	Email            string                `json:"email,omitempty"`
+	AgentID          int                   `json:"agent_id,omitempty"`
+	GroupID          int                   `json:"group_id,omitempty"`
+	DepartmentID     int                   `json:"department_id,omitempty"`
+	Subject          string                `json:"subject,omitempty"`
+	Description      string                `json:"description,omitempty"`
+	DescriptionText  string                `json:"description_text,omitempty"` // Read-Only
+	Priority         int                   `json:"priority,omitempty"`
+	Impact           int                   `json:"impact,omitempty"`
+	Status           int                   `json:"status,omitempty"`
+	Risk             int                   `json:"risk,omitempty"`
+	ChangeType       int                   `json:"change_type,omitempty"`
+	ApprovalStatus   int                   `json:"approval_status,omitempty"` // Read-Only
+	PlannedStartDate *time.Time            `json:"planned_start_date,omitempty"`
+	PlannedEndDate   *time.Time            `json:"planned_end_date,omitempty"`
+	Category         string                `json:"category,omitempty"`
+	SubCategory      string                `json:"sub_category,omitempty"`
+	ItemCategory     string                `json:"item_category,omitempty"`
+	PlanningFields   *ChangePlanningFields `json:"planning_fields,omitempty"`
+	Assets           []AssetReference      `json:"assets,omitempty"`
+	CustomFields     CustomFields          `json:"custom_fields,omitempty"`
+	CreatedAt        *time.Time            `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt        *time.Time            `json:"updated_at,omitempty"` // Read-Only
+}
+
+// ChangePlanningFields holds the planning details of a Freshservice change
+type ChangePlanningFields struct {
+	ReasonForChange *PlanningField `json:"reason_for_change,omitempty"`
+	ChangeImpact    *PlanningField `json:"change_impact,omitempty"`
+	RolloutPlan     *PlanningField `json:"rollout_plan,omitempty"`
+	BackoutPlan     *PlanningField `json:"backout_plan,omitempty"`
+}
+
+// PlanningField holds the content of a single change or release planning field
+// and is also used for the analysis fields of a problem
+type PlanningField struct {
+	Description     string `json:"description,omitempty"`
+	DescriptionText string `json:"description_text,omitempty"` // Read-Only
+}
+
+// ChangeListOptions holds the available options that can be
+// passed when requesting a list of Freshservice changes
+type ChangeListOptions struct {
+	PageQuery    string
+	PerPage      int
+	Filter       string // predefined filter e.g. my_open, unassigned, closed
+	RequesterID  *int
+	UpdatedSince *time.Time
+	Query        *Query
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *ChangeListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	if opts.Filter != "" {
+		qs = append(qs, fmt.Sprintf("filter=%s", url.QueryEscape(opts.Filter)))
+	}
+
+	if opts.RequesterID != nil {
+		qs = append(qs, fmt.Sprintf("requester_id=%d", *opts.RequesterID))
+	}
+
+	if opts.UpdatedSince != nil {
+		qs = append(qs, fmt.Sprintf("updated_since=%s", url.QueryEscape(opts.UpdatedSince.Format(time.RFC3339))))
+	}
+
+	if opts.Query != nil {
+		qs = append(qs, opts.Query.QueryString())
+	}
+
+	return strings.Join(qs, "&")
+}
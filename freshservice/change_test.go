@@ -0,0 +1,78 @@
+package freshservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestChangeCreate(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/changes", r.URL.Path)
+
+		body := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Deploy api v42", body["subject"])
+		assert.Equal(t, float64(freshservice.ChangeTypeStandard), body["change_type"])
+		assert.Equal(t, "2026-10-18T10:00:00Z", body["planned_start_date"])
+		assert.Equal(t, map[string]interface{}{
+			"reason_for_change": map[string]interface{}{"description": "Release"},
+			"backout_plan":      map[string]interface{}{"description": "Roll back to v41"},
+		}, body["planning_fields"])
+		assert.NotContains(t, body, "id")
+		assert.NotContains(t, body, "created_at")
+
+		fmt.Fprint(w, `{"change":{"id":9,"subject":"Deploy api v42","status":1}}`)
+	})
+	defer srv.Close()
+
+	start := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	cd, err := c.Changes().Create(context.Background(), &freshservice.ChangeDetails{
+		Subject:          "Deploy api v42",
+		ChangeType:       freshservice.ChangeTypeStandard,
+		Risk:             freshservice.LowRisk,
+		PlannedStartDate: &start,
+		PlannedEndDate:   &end,
+		PlanningFields: &freshservice.ChangePlanningFields{
+			ReasonForChange: &freshservice.PlanningField{Description: "Release"},
+			BackoutPlan:     &freshservice.PlanningField{Description: "Roll back to v41"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 9, cd.ID)
+	assert.Equal(t, freshservice.ChangeOpen, cd.Status)
+}
+
+func TestChangeSubResources(t *testing.T) {
+	var paths []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	_, err := c.Changes().Tasks().Get(ctx, 9, 1)
+	assert.Nil(t, err)
+	_, err = c.Changes().Notes().Get(ctx, 9, 2)
+	assert.Nil(t, err)
+	_, err = c.Changes().TimeEntries().Get(ctx, 9, 3)
+	assert.Nil(t, err)
+	_, err = c.Tasks().Get(ctx, 5, 4)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{
+		"/api/v2/changes/9/tasks/1",
+		"/api/v2/changes/9/notes/2",
+		"/api/v2/changes/9/time_entries/3",
+		"/api/v2/tickets/5/tasks/4",
+	}, paths)
+}
@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 )
 
+// Number of remaining API credits at which the default limiter starts pacing requests
+const defaultRateLimitThreshold = 10
+
 // Client represents a new Freshservice API client to
 // be utilized for API requests
 type Client struct {
@@ -20,8 +26,15 @@ type Client struct {
 
 	// Basic Authentication requried for Freshservice API calls
 	Auth *BasicAuth
+	// RetryPolicy decides whether failed requests are retried; nil disables retries
+	RetryPolicy RetryPolicy
+	// Limiter paces requests as the API credits run low; nil disables pacing
+	Limiter *RateLimiter
 	// API client to utilize for making HTTP requests
 	client *http.Client
+
+	mu        sync.Mutex
+	rateLimit RateLimit
 }
 
 // BasicAuth holds the basic auth requirements needed to
@@ -65,17 +78,46 @@ func New(ctx context.Context, domain string, apikey string, client *http.Client)
 		Auth: &BasicAuth{
 			APIKey: apikey,
 		},
-		client: client,
+		RetryPolicy: DefaultRetryPolicy(),
+		Limiter:     NewRateLimiter(defaultRateLimitThreshold),
+		client:      client,
 	}, nil
 }
 
 // makeRequest is used internally by the Freshservice API client to
 // make an API request and unmarshal into the response interface passed in
 func (fs *Client) makeRequest(r *http.Request, v interface{}) (*http.Response, error) {
-	r.Header.Set("Accept", "application/json")
+	res, err := fs.doRequest(r)
+	if err != nil {
+		return res, err
+	}
+
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			panic(err)
+		}
+	}()
+
+	if v == nil || res.StatusCode == http.StatusNoContent {
+		return res, nil
+	}
+
+	return res, json.NewDecoder(res.Body).Decode(&v)
+}
+
+// doRequest makes an API request applying the rate limiting and retry policy
+// of the client. On success the response body is left open for the caller to
+// read and close, otherwise the body is closed and an APIError returned.
+func (fs *Client) doRequest(r *http.Request) (*http.Response, error) {
+	if r.Header.Get("Accept") == "" {
+		r.Header.Set("Accept", "application/json")
+	}
 	r.Header.Set("Cache-Control", "no-store, no-cache, must-revalidate, max-age=0, post-check=0, pre-check=0")
 	r.Header.Set("Strict-Transport-Security", "max-age=31536000 ; includeSubDomains")
 	r.SetBasicAuth(fs.Auth.APIKey, "x")
+	if r.Body != nil && r.Header.Get("Content-Type") == "" {
+		r.Header.Set("Content-Type", "application/json")
+	}
 
 	// Replace scheme for unit tests that are using a mock server
 	if os.Getenv("GO_TEST") == "1" {
@@ -84,26 +126,111 @@ func (fs *Client) makeRequest(r *http.Request, v interface{}) (*http.Response, e
 
 	r.Close = true
 
-	res, err := fs.client.Do(r)
-	if err != nil {
-		return nil, fmt.Errorf("error making %s request to %s", r.Method, r.URL)
-	}
+	var res *http.Response
+	for attempt := 1; ; attempt++ {
+		if fs.Limiter != nil {
+			if err := fs.Limiter.Wait(r.Context()); err != nil {
+				closeRequestBody(r)
+				return nil, fmt.Errorf("error making %s request to %s: %w", r.Method, r.URL, err)
+			}
+		}
 
-	defer func() {
-		if err := res.Body.Close(); err != nil {
-			panic(err)
+		var err error
+		res, err = fs.client.Do(r)
+		if res != nil {
+			fs.updateRateLimit(res)
+		}
+
+		if fs.RetryPolicy != nil && (r.Body == nil || r.GetBody != nil) {
+			if wait, ok := fs.RetryPolicy.Retry(attempt, r, res, err); ok {
+				if res != nil {
+					io.Copy(ioutil.Discard, io.LimitReader(res.Body, 1<<16))
+					res.Body.Close()
+				}
+				if err := sleepContext(r.Context(), wait); err != nil {
+					closeRequestBody(r)
+					return nil, fmt.Errorf("error making %s request to %s: %w", r.Method, r.URL, err)
+				}
+				if r.GetBody != nil {
+					if r.Body, err = r.GetBody(); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
 		}
-	}()
 
-	if res.StatusCode == http.StatusNotFound {
-		return res, fmt.Errorf("%s %s not found", r.Method, r.URL)
+		if err != nil {
+			return nil, fmt.Errorf("error making %s request to %s: %w", r.Method, r.URL, err)
+		}
+		break
 	}
 
-	if v == nil {
-		return res, nil
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		defer res.Body.Close()
+		return res, newAPIError(r, res)
 	}
 
-	return res, json.NewDecoder(res.Body).Decode(&v)
+	return res, nil
+}
+
+// closeRequestBody closes the body of a request that will not be sent. The HTTP
+// client closes the body of the requests it sends, but streamed bodies such as
+// multipart uploads would otherwise leave their writer blocked forever.
+func closeRequestBody(r *http.Request) {
+	if r.Body != nil {
+		r.Body.Close()
+	}
+}
+
+// RateLimit returns the API credit state last reported by Freshservice
+func (fs *Client) RateLimit() RateLimit {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.rateLimit
+}
+
+// updateRateLimit records the rate limit headers of a response
+// and keeps the client side limiter in sync with them
+func (fs *Client) updateRateLimit(res *http.Response) {
+	rl, ok := parseRateLimit(res)
+	if !ok {
+		return
+	}
+
+	fs.mu.Lock()
+	fs.rateLimit = rl
+	fs.mu.Unlock()
+
+	if fs.Limiter != nil {
+		fs.Limiter.Update(rl)
+	}
+}
+
+// newAPIError decodes a non-2xx Freshservice response into an APIError.
+// The body is decoded on a best effort basis since some failures (e.g. from
+// a proxy in front of Freshservice) will not return the documented JSON.
+func newAPIError(r *http.Request, res *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     r.Method,
+		URL:        r.URL.String(),
+		RequestID:  res.Header.Get("X-Request-Id"),
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+
+	er := &ErrorResponse{}
+	if err := json.Unmarshal(body, er); err != nil {
+		return apiErr
+	}
+
+	apiErr.Description = er.Description
+	apiErr.Errors = er.Errors
+	return apiErr
 }
 
 // We set the scheme in the HTTP request
@@ -123,16 +250,56 @@ func (fs *Client) Assets() AssetService {
 	return &AssetServiceClient{client: fs}
 }
 
+// AssetTypes is the interface between the HTTP client and the Freshservice asset type related endpoints
+func (fs *Client) AssetTypes() AssetTypeService {
+	return &AssetTypeServiceClient{client: fs}
+}
+
 // Tickets is the interface between the HTTP client and the Freshservice ticket related endpoints
 func (fs *Client) Tickets() TicketService {
 	return &TicketServiceClient{client: fs}
 }
 
+// TicketFields is the interface between the HTTP client and the Freshservice ticket form field related endpoints
+func (fs *Client) TicketFields() TicketFieldService {
+	return &TicketFieldServiceClient{client: fs}
+}
+
+// Changes is the interface between the HTTP client and the Freshservice change related endpoints
+func (fs *Client) Changes() ChangeService {
+	return &ChangeServiceClient{client: fs}
+}
+
+// Conversations is the interface between the HTTP client and the Freshservice ticket conversation related endpoints
+func (fs *Client) Conversations() ConversationService {
+	return &ConversationServiceClient{client: fs}
+}
+
+// Problems is the interface between the HTTP client and the Freshservice problem related endpoints
+func (fs *Client) Problems() ProblemService {
+	return &ProblemServiceClient{client: fs}
+}
+
+// Releases is the interface between the HTTP client and the Freshservice release related endpoints
+func (fs *Client) Releases() ReleaseService {
+	return &ReleaseServiceClient{client: fs}
+}
+
 // ServiceCatalog is the interface between the HTTP client and the Freshservice service catalog related endpoints
 func (fs *Client) ServiceCatalog() ServiceCatalogService {
 	return &ServiceCatalogServiceClient{client: fs}
 }
 
+// Locations is the interface between the HTTP client and the Freshservice location related endpoints
+func (fs *Client) Locations() LocationService {
+	return &LocationServiceClient{client: fs}
+}
+
+// Departments is the interface between the HTTP client and the Freshservice department related endpoints
+func (fs *Client) Departments() DepartmentService {
+	return &DepartmentServiceClient{client: fs}
+}
+
 // Announcements is the interface between the HTTP client and the Freshservice announcement related endpoints
 func (fs *Client) Announcements() AnnouncementService {
 	return &AnnouncementServiceClient{client: fs}
@@ -143,11 +310,33 @@ func (fs *Client) Agents() AgentService {
 	return &AgentServiceClient{client: fs}
 }
 
+// AgentGroups is the interface between the HTTP client and the Freshservice agent group related endpoints
+func (fs *Client) AgentGroups() AgentGroupService {
+	return &AgentGroupServiceClient{client: fs}
+}
+
+// Roles is the interface between the HTTP client and the Freshservice agent role related endpoints
+func (fs *Client) Roles() RoleService {
+	return &RoleServiceClient{client: fs}
+}
+
 // BusinessHours is the interface between the HTTP client and the Freshservice business hours related endpoints
 func (fs *Client) BusinessHours() BusinessHoursService {
 	return &BusinessHoursServiceClient{client: fs}
 }
 
+// Approvals is the interface between the HTTP client and the Freshservice approval related endpoints,
+// requested for tickets. Use Changes().Approvals() or Releases().Approvals() for other parents.
+func (fs *Client) Approvals() ApprovalService {
+	return &ApprovalServiceClient{client: fs}
+}
+
+// TimeEntries is the interface between the HTTP client and the Freshservice time entry related endpoints,
+// logged against tickets. Use Changes().TimeEntries() and the like for other parents.
+func (fs *Client) TimeEntries() TimeEntryService {
+	return &TimeEntryServiceClient{client: fs}
+}
+
 // Tasks is the interface between the HTTP client and the Freshservice business hours related endpoints
 func (fs *Client) Tasks() TaskService {
 	return &TaskServiceClient{client: fs}
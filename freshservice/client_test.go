@@ -2,6 +2,11 @@ package freshservice_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,3 +44,117 @@ func TestNewClientFailMissingAPIKey(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, "A valid Freshservice API key is required to create a new API client", err.Error())
 }
+
+// newTestClient returns a client pointed at a mock server running the handler
+// passed in. The returned server should be closed once the test completes.
+func newTestClient(t *testing.T, h http.HandlerFunc) (*freshservice.Client, *httptest.Server) {
+	os.Setenv("GO_TEST", "1")
+	srv := httptest.NewServer(h)
+
+	c, err := freshservice.New(nil, srv.URL, apiKey, srv.Client())
+	assert.Nil(t, err)
+	return c, srv
+}
+
+func TestAPIErrorDecoded(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"description":"Validation failed","errors":[{"field":"email","message":"It should be a valid email address","code":"invalid_value"}]}`)
+	})
+	defer srv.Close()
+
+	_, err := c.Tickets().Create(context.Background(), &freshservice.TicketDetails{})
+	assert.True(t, errors.Is(err, freshservice.ErrValidation))
+
+	var apiErr *freshservice.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, http.MethodPost, apiErr.Method)
+	assert.Equal(t, "req-1", apiErr.RequestID)
+	assert.Equal(t, "Validation failed", apiErr.Description)
+	assert.Len(t, apiErr.FieldErrors("email"), 1)
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer srv.Close()
+
+	_, err := c.Tickets().Get(context.Background(), 1, nil)
+	assert.True(t, errors.Is(err, freshservice.ErrNotFound))
+	assert.False(t, errors.Is(err, freshservice.ErrValidation))
+}
+
+func TestNoContentResponse(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	assert.Nil(t, c.Tasks().Delete(context.Background(), 1, 2))
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	attempts := 0
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-Ratelimit-Total", "120")
+		w.Header().Set("X-Ratelimit-Used-CurrentRequest", "1")
+		if attempts < 3 {
+			w.Header().Set("X-Ratelimit-Remaining", "0")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-Ratelimit-Remaining", "119")
+		fmt.Fprint(w, `{"ticket":{"id":1}}`)
+	})
+	defer srv.Close()
+	// the limiter would otherwise pace the retries as no credits remain
+	c.Limiter = nil
+
+	td, err := c.Tickets().Create(context.Background(), &freshservice.TicketDetails{Subject: "retry"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, td.ID)
+	assert.Equal(t, 3, attempts)
+
+	rl := c.RateLimit()
+	assert.Equal(t, 120, rl.Total)
+	assert.Equal(t, 119, rl.Remaining)
+	assert.Equal(t, 1, rl.UsedCurrentRequest)
+}
+
+func TestRetryExhausted(t *testing.T) {
+	attempts := 0
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer srv.Close()
+	c.RetryPolicy = &freshservice.BackoffRetryPolicy{MaxAttempts: 2}
+
+	_, err := c.Tickets().Get(context.Background(), 1, nil)
+	assert.True(t, errors.Is(err, freshservice.ErrRateLimited))
+	assert.Equal(t, 2, attempts)
+}
+
+func TestNoRetryServerErrorOnCreate(t *testing.T) {
+	attempts := 0
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer srv.Close()
+	c.RetryPolicy = &freshservice.BackoffRetryPolicy{MaxAttempts: 3}
+
+	_, err := c.Tickets().Create(context.Background(), &freshservice.TicketDetails{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+
+	_, err = c.Tickets().Get(context.Background(), 1, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 4, attempts)
+}
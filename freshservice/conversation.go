@@ -0,0 +1,249 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const conversationURL = "/api/v2/conversations"
+
+/*
+NOTE: Replies and notes are created and listed under the ticket endpoint
+while updates and deletes use the conversation endpoint
+*/
+
+// ConversationService is an interface for interacting with
+// the ticket conversation endpoints of the Freshservice API
+type ConversationService interface {
+	List(context.Context, int, QueryFilter) ([]ConversationDetails, string, error)
+	ListAll(context.Context, int, *ConversationListOptions) *ConversationIterator
+	Reply(context.Context, int, *TicketReply) (*ConversationDetails, error)
+	ReplyWithAttachment(context.Context, int, *TicketReply, ...FileAttachment) (*ConversationDetails, error)
+	AddNote(context.Context, int, *TicketNoteDetails) (*ConversationDetails, error)
+	AddNoteWithAttachment(context.Context, int, *TicketNoteDetails, ...FileAttachment) (*ConversationDetails, error)
+	Update(context.Context, int, *ConversationDetails) (*ConversationDetails, error)
+	Delete(context.Context, int) error
+}
+
+// ConversationServiceClient facilitates requests with the ConversationService methods
+type ConversationServiceClient struct {
+	client *Client
+}
+
+// List all replies and notes on a given ticket ID
+func (c *ConversationServiceClient) List(ctx context.Context, tickID int, filter QueryFilter) ([]ConversationDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/conversations", ticketURL, tickID),
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Conversations{}
+	resp, err := c.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every reply and note on a given
+// ticket ID, requesting additional pages as the iterator advances
+func (c *ConversationServiceClient) ListAll(ctx context.Context, tickID int, opts *ConversationListOptions) *ConversationIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &ConversationIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := c.List(ctx, tickID, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// ConversationIterator iterates over a paginated list of Freshservice ticket conversations
+type ConversationIterator struct {
+	pager
+	page []ConversationDetails
+}
+
+// Value returns the conversation the iterator currently points at
+func (it *ConversationIterator) Value() ConversationDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max conversations.
+// A max of zero or less will collect every remaining conversation.
+func (it *ConversationIterator) Collect(ctx context.Context, max int) ([]ConversationDetails, error) {
+	var list []ConversationDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Reply sends a public reply to the requester of a given ticket ID
+func (c *ConversationServiceClient) Reply(ctx context.Context, tickID int, reply *TicketReply) (*ConversationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/reply", ticketURL, tickID),
+	}
+
+	replyContent, err := json.Marshal(reply)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(replyContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// ReplyWithAttachment sends a public reply with attachments to the requester of a given ticket ID
+func (c *ConversationServiceClient) ReplyWithAttachment(ctx context.Context, tickID int, reply *TicketReply, files ...FileAttachment) (*ConversationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/reply", ticketURL, tickID),
+	}
+
+	req, err := newMultipartRequest(ctx, http.MethodPost, url.String(), reply, files)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// AddNote adds a note to a given ticket ID. Private is always sent, so it must
+// be set for a private note, and the agents listed in NotifyEmails will be
+// notified of the note.
+func (c *ConversationServiceClient) AddNote(ctx context.Context, tickID int, note *TicketNoteDetails) (*ConversationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/notes", ticketURL, tickID),
+	}
+
+	noteContent, err := json.Marshal(note)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(noteContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// AddNoteWithAttachment adds a note with attachments to a given ticket ID
+func (c *ConversationServiceClient) AddNoteWithAttachment(ctx context.Context, tickID int, note *TicketNoteDetails, files ...FileAttachment) (*ConversationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/notes", ticketURL, tickID),
+	}
+
+	req, err := newMultipartRequest(ctx, http.MethodPost, url.String(), note, files)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a specific conversation by ID. Only notes and the body of
+// outgoing replies can be updated.
+func (c *ConversationServiceClient) Update(ctx context.Context, id int, details *ConversationDetails) (*ConversationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", conversationURL, id),
+	}
+
+	conversationContent, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(conversationContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Conversation{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a specific conversation by ID
+// Note: Deleted conversations are permanently lost.
+func (c *ConversationServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", conversationURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
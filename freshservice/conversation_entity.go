@@ -0,0 +1,67 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+// Conversations holds a list of Freshservice ticket conversations
+type Conversations struct {
+	List []ConversationDetails `json:"conversations"`
+}
+
+// Conversation holds the details of a specific Freshservice ticket conversation
+type Conversation struct {
+	Details ConversationDetails `json:"conversation"`
+}
+
+// ConversationDetails contains the details of a reply or note on a Freshservice ticket
+type ConversationDetails struct {
+	ID           int          `json:"id,omitempty"` // Read-Only
+	UserID       int          `json:"user_id,omitempty"`
+	TicketID     int          `json:"ticket_id,omitempty"` // Read-Only
+	Source       int          `json:"source,omitempty"`
+	Incoming     bool         `json:"incoming,omitempty"`
+	Private      *bool        `json:"private,omitempty"`
+	Body         string       `json:"body,omitempty"`
+	BodyText     string       `json:"body_text,omitempty"` // Read-Only
+	FromEmail    string       `json:"from_email,omitempty"`
+	ToEmails     []string     `json:"to_emails,omitempty"`
+	CcEmails     []string     `json:"cc_emails,omitempty"`
+	BccEmails    []string     `json:"bcc_emails,omitempty"`
+	NotifyEmails []string     `json:"notify_emails,omitempty"`
+	SupportEmail string       `json:"support_email,omitempty"`
+	Attachments  []Attachment `json:"attachments,omitempty"` // Read-Only
+	CreatedAt    *time.Time   `json:"created_at,omitempty"`  // Read-Only
+	UpdatedAt    *time.Time   `json:"updated_at,omitempty"`  // Read-Only
+}
+
+// TicketReply holds the details of a public reply to be sent on a Freshservice ticket
+type TicketReply struct {
+	Body      string   `json:"body"` // Mandatory
+	FromEmail string   `json:"from_email,omitempty"`
+	UserID    int      `json:"user_id,omitempty"`
+	CcEmails  []string `json:"cc_emails,omitempty"`
+	BccEmails []string `json:"bcc_emails,omitempty"`
+}
+
+// ConversationListOptions holds the available options that can be
+// passed when requesting the conversations of a Freshservice ticket
+type ConversationListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *ConversationListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
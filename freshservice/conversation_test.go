@@ -0,0 +1,133 @@
+package freshservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestConversationAddPrivateNote(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/tickets/42/notes", r.URL.Path)
+
+		body := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"body":          "Paged the on-call engineer",
+			"private":       true,
+			"notify_emails": []interface{}{"oncall@example.com"},
+		}, body)
+
+		fmt.Fprint(w, `{"conversation":{"id":7,"ticket_id":42,"private":true,"body_text":"Paged the on-call engineer"}}`)
+	})
+	defer srv.Close()
+
+	note, err := c.Conversations().AddNote(context.Background(), 42, &freshservice.TicketNoteDetails{
+		Body:         "Paged the on-call engineer",
+		Private:      true,
+		NotifyEmails: []string{"oncall@example.com"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 7, note.ID)
+	assert.Equal(t, 42, note.TicketID)
+	assert.True(t, *note.Private)
+}
+
+func TestConversationAddPublicNote(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"body": "Fixed in 4.2", "private": false}, body)
+
+		fmt.Fprint(w, `{"conversation":{"id":8,"ticket_id":42,"private":false}}`)
+	})
+	defer srv.Close()
+
+	note, err := c.Conversations().AddNote(context.Background(), 42, &freshservice.TicketNoteDetails{
+		Body: "Fixed in 4.2",
+	})
+	assert.Nil(t, err)
+	assert.False(t, *note.Private)
+}
+
+func TestConversationReply(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/tickets/42/reply", r.URL.Path)
+
+		body := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"body":      "We are looking into it",
+			"cc_emails": []interface{}{"lead@example.com"},
+		}, body)
+
+		fmt.Fprint(w, `{"conversation":{"id":9,"ticket_id":42,"incoming":false,"body_text":"We are looking into it"}}`)
+	})
+	defer srv.Close()
+
+	reply, err := c.Conversations().Reply(context.Background(), 42, &freshservice.TicketReply{
+		Body:     "We are looking into it",
+		CcEmails: []string{"lead@example.com"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 9, reply.ID)
+	assert.Equal(t, "We are looking into it", reply.BodyText)
+}
+
+func TestConversationList(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v2/tickets/42/conversations", r.URL.Path)
+		if r.URL.Query().Get("page") == "" {
+			assert.Equal(t, "10", r.URL.Query().Get("per_page"))
+			w.Header().Set("Link", fmt.Sprintf(`<https://%s/api/v2/tickets/42/conversations?page=2&per_page=10>; rel="next"`, r.Host))
+			fmt.Fprint(w, `{"conversations":[{"id":1,"private":true},{"id":2,"private":false}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"conversations":[{"id":3}]}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	list, next, err := c.Conversations().List(ctx, 42, &freshservice.ConversationListOptions{PerPage: 10})
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "page=2&per_page=10", next)
+
+	all, err := c.Conversations().ListAll(ctx, 42, &freshservice.ConversationListOptions{PerPage: 10}).Collect(ctx, 0)
+	assert.Nil(t, err)
+	assert.Len(t, all, 3)
+	assert.Nil(t, all[2].Private)
+}
+
+func TestConversationUpdateAndDelete(t *testing.T) {
+	var calls []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPut {
+			body := map[string]interface{}{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"body": "Updated", "private": false}, body)
+			fmt.Fprint(w, `{"conversation":{"id":7,"body":"Updated","private":false}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	conv, err := c.Conversations().Update(ctx, 7, &freshservice.ConversationDetails{Body: "Updated", Private: freshservice.Bool(false)})
+	assert.Nil(t, err)
+	assert.Equal(t, "Updated", conv.Body)
+	assert.False(t, *conv.Private)
+
+	assert.Nil(t, c.Conversations().Delete(ctx, 7))
+	assert.Equal(t, []string{"PUT /api/v2/conversations/7", "DELETE /api/v2/conversations/7"}, calls)
+}
@@ -0,0 +1,191 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const departmentURL = "/api/v2/departments"
+
+// DepartmentService is an interface for interacting with
+// the department endpoints of the Freshservice API
+type DepartmentService interface {
+	List(context.Context, QueryFilter) ([]DepartmentDetails, string, error)
+	ListAll(context.Context, *DepartmentListOptions) *DepartmentIterator
+	Create(context.Context, *DepartmentDetails) (*DepartmentDetails, error)
+	Get(context.Context, int) (*DepartmentDetails, error)
+	Update(context.Context, int, *DepartmentDetails) (*DepartmentDetails, error)
+	Delete(context.Context, int) error
+}
+
+// DepartmentServiceClient facilitates requests with the DepartmentService methods
+type DepartmentServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice departments
+func (ds *DepartmentServiceClient) List(ctx context.Context, filter QueryFilter) ([]DepartmentDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ds.client.Domain,
+		Path:   departmentURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Departments{}
+	resp, err := ds.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice department matching the
+// options passed in, requesting additional pages as the iterator advances
+func (ds *DepartmentServiceClient) ListAll(ctx context.Context, opts *DepartmentListOptions) *DepartmentIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &DepartmentIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := ds.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// DepartmentIterator iterates over a paginated list of Freshservice departments
+type DepartmentIterator struct {
+	pager
+	page []DepartmentDetails
+}
+
+// Value returns the department the iterator currently points at
+func (it *DepartmentIterator) Value() DepartmentDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max departments.
+// A max of zero or less will collect every remaining department.
+func (it *DepartmentIterator) Collect(ctx context.Context, max int) ([]DepartmentDetails, error) {
+	var list []DepartmentDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Create a new Freshservice department. Requesters can be provisioned
+// into the department once it exists by setting their DepartmentIDs.
+func (ds *DepartmentServiceClient) Create(ctx context.Context, dd *DepartmentDetails) (*DepartmentDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ds.client.Domain,
+		Path:   departmentURL,
+	}
+
+	departmentContent, err := json.Marshal(dd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(departmentContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Department{}
+	if _, err := ds.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific Freshservice department
+func (ds *DepartmentServiceClient) Get(ctx context.Context, id int) (*DepartmentDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ds.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", departmentURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Department{}
+	if _, err := ds.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice department
+func (ds *DepartmentServiceClient) Update(ctx context.Context, id int, dd *DepartmentDetails) (*DepartmentDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ds.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", departmentURL, id),
+	}
+
+	departmentContent, err := json.Marshal(dd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(departmentContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Department{}
+	if _, err := ds.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice department
+// Note: Deleted departments are permanently lost.
+func (ds *DepartmentServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ds.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", departmentURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ds.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+// Departments holds a list of Freshservice departments
+type Departments struct {
+	List []DepartmentDetails `json:"departments"`
+}
+
+// Department holds the details of a specific Freshservice department
+type Department struct {
+	Details DepartmentDetails `json:"department"`
+}
+
+// DepartmentDetails are the details related to a specific department, known
+// as a business unit in some Freshservice plans. Requesters whose email matches
+// one of the Domains are added to the department automatically.
+type DepartmentDetails struct {
+	ID           int          `json:"id,omitempty"` // Read-Only
+	Name         string       `json:"name,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	HeadUserID   int          `json:"head_user_id,omitempty"`
+	PrimeUserID  int          `json:"prime_user_id,omitempty"`
+	Domains      []string     `json:"domains,omitempty"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
+	CreatedAt    *time.Time   `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt    *time.Time   `json:"updated_at,omitempty"` // Read-Only
+}
+
+// DepartmentListOptions holds the available options that can be
+// passed when requesting a list of Freshservice departments
+type DepartmentListOptions struct {
+	PageQuery string
+	PerPage   int
+	// Query matches departments by name
+	Query *Query
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *DepartmentListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	if opts.Query != nil {
+		qs = append(qs, opts.Query.QueryString())
+	}
+
+	return strings.Join(qs, "&")
+}
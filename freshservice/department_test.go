@@ -0,0 +1,48 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestDepartmentHeadsAndDomains(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/departments/3", r.URL.Path)
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"department":{"id":3,"name":"Finance","head_user_id":12,"prime_user_id":14,
+				"domains":["finance.example.com","fin.example.com"],"custom_fields":{"cost_center":"CC-100","headcount":42}}}`)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"prime_user_id":15,"domains":["finance.example.com"],"custom_fields":{"cost_center":"CC-200"}}`, string(body))
+		fmt.Fprint(w, `{"department":{"id":3,"name":"Finance","head_user_id":12,"prime_user_id":15,"domains":["finance.example.com"]}}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	dept, err := c.Departments().Get(ctx, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 12, dept.HeadUserID)
+	assert.Equal(t, 14, dept.PrimeUserID)
+	assert.Equal(t, []string{"finance.example.com", "fin.example.com"}, dept.Domains)
+	assert.Equal(t, "CC-100", dept.CustomFields["cost_center"])
+	assert.Equal(t, float64(42), dept.CustomFields["headcount"])
+
+	// handing the department over keeps the head and only sends what changes
+	dept, err = c.Departments().Update(ctx, 3, &freshservice.DepartmentDetails{
+		PrimeUserID:  15,
+		Domains:      []string{"finance.example.com"},
+		CustomFields: freshservice.CustomFields{"cost_center": "CC-200"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 12, dept.HeadUserID)
+	assert.Equal(t, 15, dept.PrimeUserID)
+	assert.Len(t, dept.Domains, 1)
+}
@@ -3,6 +3,23 @@ package freshservice
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrValidation is matched by errors.Is when the Freshservice API rejects a payload (HTTP 400)
+	ErrValidation = errors.New("freshservice: validation failed")
+	// ErrUnauthorized is matched by errors.Is when the API key is missing or invalid (HTTP 401)
+	ErrUnauthorized = errors.New("freshservice: unauthorized")
+	// ErrForbidden is matched by errors.Is when the API key lacks access to a resource (HTTP 403)
+	ErrForbidden = errors.New("freshservice: forbidden")
+	// ErrNotFound is matched by errors.Is when the requested resource does not exist (HTTP 404)
+	ErrNotFound = errors.New("freshservice: not found")
+	// ErrRateLimited is matched by errors.Is when the API credit limit has been reached (HTTP 429)
+	ErrRateLimited = errors.New("freshservice: rate limited")
+	// ErrAttachmentTooLarge is returned when the attachments of a request exceed MaxAttachmentSize
+	ErrAttachmentTooLarge = errors.New("freshservice: attachments exceed the 15 MB limit")
 )
 
 // ErrorResponse represents a Freshservice API error
@@ -18,6 +35,72 @@ type Error struct {
 	Code    string `json:"code"`
 }
 
+// APIError is returned for every non-2xx response from the Freshservice API.
+// Use errors.Is with the Err* sentinels to branch on the kind of failure and
+// errors.As to inspect the field level details.
+type APIError struct {
+	StatusCode  int
+	Method      string
+	URL         string
+	RequestID   string
+	Description string
+	Errors      []Error
+}
+
+// Error satisfies the error interface
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s returned %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Description != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Description)
+	}
+
+	var fields []string
+	for _, fe := range e.Errors {
+		if fe.Field != "" {
+			fields = append(fields, fmt.Sprintf("%s: %s", fe.Field, fe.Message))
+		} else {
+			fields = append(fields, fe.Message)
+		}
+	}
+	if len(fields) > 0 {
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(fields, "; "))
+	}
+
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s [request id %s]", msg, e.RequestID)
+	}
+
+	return msg
+}
+
+// Is allows the APIError to be matched against the Err* sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return target == ErrValidation
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return false
+}
+
+// FieldErrors returns the errors reported by Freshservice for a specific payload field
+func (e *APIError) FieldErrors(field string) []Error {
+	var errs []Error
+	for _, fe := range e.Errors {
+		if fe.Field == field {
+			errs = append(errs, fe)
+		}
+	}
+	return errs
+}
+
 // Helper to be used for API client config errors
 func missingClientConfigErr(attr string) error {
 	errTxt := fmt.Sprintf("A valid Freshservice %s is required to create a new API client", attr)
@@ -1,6 +1,8 @@
 package freshservice
 
 import (
+	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,3 +33,44 @@ func TestMissingClientConfigErr(t *testing.T) {
 		assert.Equal(t, c.Expected, err.Error())
 	}
 }
+
+func TestAPIErrorIs(t *testing.T) {
+	cases := []struct {
+		StatusCode int
+		Expected   error
+	}{
+		{StatusCode: http.StatusBadRequest, Expected: ErrValidation},
+		{StatusCode: http.StatusUnauthorized, Expected: ErrUnauthorized},
+		{StatusCode: http.StatusForbidden, Expected: ErrForbidden},
+		{StatusCode: http.StatusNotFound, Expected: ErrNotFound},
+		{StatusCode: http.StatusTooManyRequests, Expected: ErrRateLimited},
+	}
+
+	for _, c := range cases {
+		var err error = &APIError{StatusCode: c.StatusCode}
+		assert.True(t, errors.Is(err, c.Expected))
+		assert.False(t, errors.Is(err, errors.New("other")))
+	}
+
+	var err error = &APIError{StatusCode: http.StatusInternalServerError}
+	assert.False(t, errors.Is(err, ErrNotFound))
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	err := &APIError{
+		StatusCode:  http.StatusBadRequest,
+		Method:      http.MethodPost,
+		URL:         "https://domain.freshservice.com/api/v2/tickets",
+		RequestID:   "abc-123",
+		Description: "Validation failed",
+		Errors: []Error{
+			{Field: "email", Message: "It should be a valid email address", Code: "invalid_value"},
+			{Field: "priority", Message: "It should be one of these values: '1,2,3,4'", Code: "invalid_value"},
+		},
+	}
+
+	assert.Equal(t, "POST https://domain.freshservice.com/api/v2/tickets returned 400 Bad Request: Validation failed "+
+		"(email: It should be a valid email address; priority: It should be one of these values: '1,2,3,4') [request id abc-123]", err.Error())
+	assert.Len(t, err.FieldErrors("email"), 1)
+	assert.Empty(t, err.FieldErrors("subject"))
+}
@@ -0,0 +1,191 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const locationURL = "/api/v2/locations"
+
+// LocationService is an interface for interacting with
+// the location endpoints of the Freshservice API
+type LocationService interface {
+	List(context.Context, QueryFilter) ([]LocationDetails, string, error)
+	ListAll(context.Context, *LocationListOptions) *LocationIterator
+	Create(context.Context, *LocationDetails) (*LocationDetails, error)
+	Get(context.Context, int) (*LocationDetails, error)
+	Update(context.Context, int, *LocationDetails) (*LocationDetails, error)
+	Delete(context.Context, int) error
+}
+
+// LocationServiceClient facilitates requests with the LocationService methods
+type LocationServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice locations
+func (ls *LocationServiceClient) List(ctx context.Context, filter QueryFilter) ([]LocationDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ls.client.Domain,
+		Path:   locationURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Locations{}
+	resp, err := ls.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice location matching the
+// options passed in, requesting additional pages as the iterator advances
+func (ls *LocationServiceClient) ListAll(ctx context.Context, opts *LocationListOptions) *LocationIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &LocationIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := ls.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// LocationIterator iterates over a paginated list of Freshservice locations
+type LocationIterator struct {
+	pager
+	page []LocationDetails
+}
+
+// Value returns the location the iterator currently points at
+func (it *LocationIterator) Value() LocationDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max locations.
+// A max of zero or less will collect every remaining location.
+func (it *LocationIterator) Collect(ctx context.Context, max int) ([]LocationDetails, error) {
+	var list []LocationDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Create a new Freshservice location. Set the ParentLocationID
+// to place it within an existing location, e.g. a floor within a building.
+func (ls *LocationServiceClient) Create(ctx context.Context, ld *LocationDetails) (*LocationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ls.client.Domain,
+		Path:   locationURL,
+	}
+
+	locationContent, err := json.Marshal(ld)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(locationContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Location{}
+	if _, err := ls.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific Freshservice location
+func (ls *LocationServiceClient) Get(ctx context.Context, id int) (*LocationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ls.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", locationURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Location{}
+	if _, err := ls.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice location
+func (ls *LocationServiceClient) Update(ctx context.Context, id int, ld *LocationDetails) (*LocationDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ls.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", locationURL, id),
+	}
+
+	locationContent, err := json.Marshal(ld)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(locationContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Location{}
+	if _, err := ls.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice location
+// Note: Deleted locations are permanently lost.
+func (ls *LocationServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   ls.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", locationURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ls.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+// Locations holds a list of Freshservice locations
+type Locations struct {
+	List []LocationDetails `json:"locations"`
+}
+
+// Location holds the details of a specific Freshservice location
+type Location struct {
+	Details LocationDetails `json:"location"`
+}
+
+// LocationDetails are the details related to a specific location. Locations
+// form a hierarchy through the ParentLocationID, e.g. a floor within a building.
+type LocationDetails struct {
+	ID               int              `json:"id,omitempty"` // Read-Only
+	Name             string           `json:"name,omitempty"`
+	ParentLocationID int              `json:"parent_location_id,omitempty"`
+	PrimaryContactID int              `json:"primary_contact_id,omitempty"`
+	Address          *LocationAddress `json:"address,omitempty"`
+	CreatedAt        *time.Time       `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt        *time.Time       `json:"updated_at,omitempty"` // Read-Only
+}
+
+// LocationAddress holds the postal address of a location
+type LocationAddress struct {
+	Line1   string `json:"line1,omitempty"`
+	Line2   string `json:"line2,omitempty"`
+	City    string `json:"city,omitempty"`
+	State   string `json:"state,omitempty"`
+	Country string `json:"country,omitempty"`
+	Zipcode string `json:"zipcode,omitempty"`
+}
+
+// LocationListOptions holds the available options that can be
+// passed when requesting a list of Freshservice locations
+type LocationListOptions struct {
+	PageQuery string
+	PerPage   int
+	// Query matches locations by name
+	Query *Query
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *LocationListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	if opts.Query != nil {
+		qs = append(qs, opts.Query.QueryString())
+	}
+
+	return strings.Join(qs, "&")
+}
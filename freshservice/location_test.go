@@ -0,0 +1,54 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestLocationHierarchy(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/locations/8", r.URL.Path)
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"location":{"id":8,"name":"Floor 2","parent_location_id":5,"primary_contact_id":12,
+				"address":{"line1":"1 Main St","line2":null,"city":"Chennai","state":"Tamil Nadu","country":"India","zipcode":"600001"}}}`)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"parent_location_id":6,"address":{"line1":"2 Park Rd","city":"Chennai","country":"India"}}`, string(body))
+		fmt.Fprint(w, `{"location":{"id":8,"name":"Floor 2","parent_location_id":6}}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	floor, err := c.Locations().Get(ctx, 8)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, floor.ParentLocationID)
+	assert.Equal(t, 12, floor.PrimaryContactID)
+	assert.Equal(t, &freshservice.LocationAddress{
+		Line1:   "1 Main St",
+		City:    "Chennai",
+		State:   "Tamil Nadu",
+		Country: "India",
+		Zipcode: "600001",
+	}, floor.Address)
+
+	// moving the floor to another building only sends the parent and the new address
+	floor, err = c.Locations().Update(ctx, 8, &freshservice.LocationDetails{
+		ParentLocationID: 6,
+		Address: &freshservice.LocationAddress{
+			Line1:   "2 Park Rd",
+			City:    "Chennai",
+			Country: "India",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 6, floor.ParentLocationID)
+	assert.Nil(t, floor.Address)
+}
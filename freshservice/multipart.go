@@ -0,0 +1,189 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxAttachmentSize is the total size of all attachments Freshservice accepts on a single request
+const MaxAttachmentSize = 15 * 1024 * 1024
+
+// FileAttachment is a file to be uploaded to Freshservice as part of a request.
+// Requests with attachments are streamed rather than buffered, so they are never
+// retried by the RetryPolicy, not even when rate limited. Check for ErrRateLimited
+// with errors.Is and send the request again with fresh readers.
+type FileAttachment struct {
+	// Name is the file name shown in Freshservice
+	Name string
+	// ContentType defaults to application/octet-stream when empty
+	ContentType string
+	// Content is streamed to Freshservice as the request is sent
+	Content io.Reader
+	// Size is optional; when set oversized uploads are rejected before any bytes are sent
+	Size int64
+}
+
+// newMultipartRequest creates a multipart/form-data request where the payload
+// is encoded as form fields and the files are sent as attachments[]. The body
+// is streamed as it is read by the HTTP client rather than buffered in memory,
+// which also means the request can not be retried.
+func newMultipartRequest(ctx context.Context, method string, url string, payload interface{}, files []FileAttachment) (*http.Request, error) {
+	var total int64
+	for _, f := range files {
+		if f.Content == nil {
+			return nil, fmt.Errorf("attachment %q has no content", f.Name)
+		}
+		total += f.Size
+	}
+
+	if total > MaxAttachmentSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	fields, err := formFields(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		pw.CloseWithError(writeMultipart(mw, fields, files))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, pr)
+	if err != nil {
+		pr.Close()
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req, nil
+}
+
+func writeMultipart(mw *multipart.Writer, fields []formField, files []FileAttachment) error {
+	for _, f := range fields {
+		if err := mw.WriteField(f.key, f.value); err != nil {
+			return err
+		}
+	}
+
+	remaining := &limitedWriter{remaining: MaxAttachmentSize}
+	for _, f := range files {
+		contentType := f.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="attachments[]"; filename="%s"`, escapeQuotes(f.Name)))
+		h.Set("Content-Type", contentType)
+
+		part, err := mw.CreatePart(h)
+		if err != nil {
+			return err
+		}
+
+		remaining.w = part
+		if _, err := io.Copy(remaining, f.Content); err != nil {
+			return err
+		}
+	}
+
+	return mw.Close()
+}
+
+// limitedWriter fails once more than the remaining number of bytes have been written
+type limitedWriter struct {
+	w         io.Writer
+	remaining int64
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	lw.remaining -= int64(len(p))
+	if lw.remaining < 0 {
+		return 0, ErrAttachmentTooLarge
+	}
+	return lw.w.Write(p)
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+// formField is a single encoded key/value pair of a multipart form
+type formField struct {
+	key   string
+	value string
+}
+
+// formFields encodes a JSON payload into the bracketed form field names used
+// by Freshservice, e.g. custom_fields[cost_center] and cc_emails[]. Any
+// attachments already present on the payload are dropped as files are sent separately.
+func formFields(payload interface{}) ([]formField, error) {
+	if payload == nil {
+		return nil, nil
+	}
+
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	var m map[string]interface{}
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	delete(m, "attachments")
+
+	var fields []formField
+	flattenFormField(&fields, "", m)
+	return fields, nil
+}
+
+func flattenFormField(fields *[]formField, key string, v interface{}) {
+	switch val := v.(type) {
+	case nil:
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if key == "" {
+				flattenFormField(fields, k, val[k])
+			} else {
+				flattenFormField(fields, fmt.Sprintf("%s[%s]", key, k), val[k])
+			}
+		}
+	case []interface{}:
+		for _, item := range val {
+			flattenFormField(fields, key+"[]", item)
+		}
+	case bool:
+		*fields = append(*fields, formField{key: key, value: strconv.FormatBool(val)})
+	case json.Number:
+		*fields = append(*fields, formField{key: key, value: val.String()})
+	case string:
+		*fields = append(*fields, formField{key: key, value: val})
+	default:
+		*fields = append(*fields, formField{key: key, value: fmt.Sprint(val)})
+	}
+}
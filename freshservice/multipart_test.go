@@ -0,0 +1,108 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormFields(t *testing.T) {
+	payload := &TicketReply{
+		Body:     "See attached",
+		UserID:   12,
+		CcEmails: []string{"a@example.com", "b@example.com"},
+	}
+
+	fields, err := formFields(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, []formField{
+		{key: "body", value: "See attached"},
+		{key: "cc_emails[]", value: "a@example.com"},
+		{key: "cc_emails[]", value: "b@example.com"},
+		{key: "user_id", value: "12"},
+	}, fields)
+}
+
+func TestFormFieldsNested(t *testing.T) {
+	payload := map[string]interface{}{
+		"subject":       "Laptop request",
+		"urgent":        true,
+		"custom_fields": CustomFields{"cost_center": "ENG-42", "seats": 3},
+		"attachments":   []Attachment{{Name: "ignored.txt"}},
+	}
+
+	fields, err := formFields(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, []formField{
+		{key: "custom_fields[cost_center]", value: "ENG-42"},
+		{key: "custom_fields[seats]", value: "3"},
+		{key: "subject", value: "Laptop request"},
+		{key: "urgent", value: "true"},
+	}, fields)
+}
+
+func TestMultipartRequest(t *testing.T) {
+	files := []FileAttachment{
+		{Name: `evidence "1".log`, ContentType: "text/plain", Content: strings.NewReader("first file")},
+		{Name: "evidence-2.bin", Content: bytes.NewReader([]byte{0x1, 0x2})},
+	}
+
+	req, err := newMultipartRequest(context.Background(), http.MethodPost, "https://domain.freshservice.com/api/v2/tickets", &TicketReply{Body: "hello"}, files)
+	assert.Nil(t, err)
+	assert.Nil(t, req.GetBody)
+	assert.Nil(t, req.ParseMultipartForm(1<<20))
+
+	assert.Equal(t, "hello", req.FormValue("body"))
+
+	attachments := req.MultipartForm.File["attachments[]"]
+	assert.Len(t, attachments, 2)
+	assert.Equal(t, `evidence "1".log`, attachments[0].Filename)
+	assert.Equal(t, "text/plain", attachments[0].Header.Get("Content-Type"))
+	assert.Equal(t, "application/octet-stream", attachments[1].Header.Get("Content-Type"))
+
+	f, err := attachments[0].Open()
+	assert.Nil(t, err)
+	content, _ := ioutil.ReadAll(f)
+	assert.Equal(t, "first file", string(content))
+}
+
+func TestMultipartRequestTooLarge(t *testing.T) {
+	files := []FileAttachment{{Name: "big.iso", Content: strings.NewReader(""), Size: MaxAttachmentSize + 1}}
+	_, err := newMultipartRequest(context.Background(), http.MethodPost, "https://domain.freshservice.com", nil, files)
+	assert.Equal(t, ErrAttachmentTooLarge, err)
+
+	// the limit is enforced while streaming when the size is not known upfront
+	files = []FileAttachment{{Name: "big.iso", Content: bytes.NewReader(make([]byte, MaxAttachmentSize+1))}}
+	req, err := newMultipartRequest(context.Background(), http.MethodPost, "https://domain.freshservice.com", nil, files)
+	assert.Nil(t, err)
+	_, err = ioutil.ReadAll(req.Body)
+	assert.Equal(t, ErrAttachmentTooLarge, err)
+}
+
+func TestMultipartBodyClosedWhenNotSent(t *testing.T) {
+	c, err := New(nil, "domain.freshservice.com", "key", nil)
+	assert.Nil(t, err)
+	c.Limiter.Update(RateLimit{Total: 100, Remaining: 0, UpdatedAt: time.Now()})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := newMultipartRequest(ctx, http.MethodPost, "https://domain.freshservice.com/api/v2/tickets", &TicketReply{Body: "See attached"},
+		[]FileAttachment{{Name: "log.txt", Content: strings.NewReader("contents")}})
+	assert.Nil(t, err)
+
+	_, err = c.doRequest(req)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	// the writer goroutine is released once the pipe is closed
+	_, err = req.Body.Read(make([]byte, 1))
+	assert.Equal(t, io.ErrClosedPipe, err)
+}
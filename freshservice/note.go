@@ -0,0 +1,156 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+/*
+NOTE: Notes are nested under a parent endpoint such as Changes().Notes() and
+the ID passed to each method is that of the parent change, problem or release
+*/
+
+// NoteService is an interface for interacting with the note
+// endpoints of Freshservice changes, problems and releases
+type NoteService interface {
+	List(context.Context, int, QueryFilter) ([]NoteDetails, string, error)
+	Create(context.Context, int, *NoteDetails) (*NoteDetails, error)
+	Get(context.Context, int, int) (*NoteDetails, error)
+	Update(context.Context, int, int, *NoteDetails) (*NoteDetails, error)
+	Delete(context.Context, int, int) error
+}
+
+// NoteServiceClient facilitates requests with the NoteService methods
+type NoteServiceClient struct {
+	client *Client
+	// parentURL is the endpoint the notes are nested under
+	parentURL string
+}
+
+// List all notes added to a given parent ID
+func (c *NoteServiceClient) List(ctx context.Context, parentID int, filter QueryFilter) ([]NoteDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/notes", c.parentURL, parentID),
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Notes{}
+	resp, err := c.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// Create a note on a given parent ID
+func (c *NoteServiceClient) Create(ctx context.Context, parentID int, nd *NoteDetails) (*NoteDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/notes", c.parentURL, parentID),
+	}
+
+	noteContent, err := json.Marshal(nd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(noteContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Note{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific note added to a given parent ID
+func (c *NoteServiceClient) Get(ctx context.Context, parentID int, id int) (*NoteDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/notes/%d", c.parentURL, parentID, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Note{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a specific note added to a given parent ID
+func (c *NoteServiceClient) Update(ctx context.Context, parentID int, id int, nd *NoteDetails) (*NoteDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/notes/%d", c.parentURL, parentID, id),
+	}
+
+	noteContent, err := json.Marshal(nd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(noteContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Note{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a specific note added to a given parent ID
+// Note: Deleted notes are permanently lost.
+func (c *NoteServiceClient) Delete(ctx context.Context, parentID int, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/notes/%d", c.parentURL, parentID, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
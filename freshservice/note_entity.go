@@ -0,0 +1,48 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+// Notes holds a list of Freshservice notes
+type Notes struct {
+	List []NoteDetails `json:"notes"`
+}
+
+// Note holds the details of a specific Freshservice note
+type Note struct {
+	Details NoteDetails `json:"note"`
+}
+
+// NoteDetails are the details of a note added to a Freshservice change, problem or release
+type NoteDetails struct {
+	ID           int        `json:"id,omitempty"`         // Read-Only
+	CreatedBy    int        `json:"created_by,omitempty"` // Read-Only
+	Body         string     `json:"body,omitempty"`       // Mandatory
+	BodyText     string     `json:"body_text,omitempty"`  // Read-Only
+	NotifyEmails []string   `json:"notify_emails,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"` // Read-Only
+}
+
+// NoteListOptions holds the available options that can be
+// passed when requesting a list of Freshservice notes
+type NoteListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *NoteListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
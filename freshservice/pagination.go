@@ -0,0 +1,124 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxPerPage is the largest page size accepted by the Freshservice API.
+// Larger values set on list options are capped to this size.
+const MaxPerPage = 100
+
+// perPageQuery returns the per_page query parameter capped to MaxPerPage
+func perPageQuery(n int) string {
+	if n > MaxPerPage {
+		n = MaxPerPage
+	}
+	return fmt.Sprintf("per_page=%d", n)
+}
+
+// pageQuery is the raw query string returned by HasNextPage which already
+// carries every parameter of the original request, so it can be passed as is
+type pageQuery string
+
+// QueryString allows a page query to meet the QueryFilter interface
+func (pq pageQuery) QueryString() string {
+	return string(pq)
+}
+
+// pageFunc fetches a single page for an iterator. It should return the number
+// of items loaded and the query for the following page, empty on the last page.
+type pageFunc func(context.Context, QueryFilter) (int, string, error)
+
+// pager holds the paging state shared by the list iterators. The typed
+// iterators embed it and load the items of the current page in fetch.
+type pager struct {
+	ctx     context.Context
+	fetch   pageFunc
+	filter  QueryFilter
+	size    int
+	pos     int
+	next    string
+	fetched bool
+	err     error
+}
+
+// newPager returns the paging state of an iterator. The context passed in
+// bounds the lifetime of the whole iteration, while the context passed to
+// Next bounds the page requested by that call.
+func newPager(ctx context.Context, filter QueryFilter, fetch pageFunc) pager {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return pager{
+		ctx:    ctx,
+		fetch:  fetch,
+		filter: filter,
+		pos:    -1,
+	}
+}
+
+// Next advances the iterator, requesting the next page from Freshservice
+// once the current one is exhausted. It returns false when there are no
+// more items or an error occurred, which can be checked with Err.
+func (p *pager) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.pos++
+	for p.pos >= p.size {
+		if p.fetched && p.next == "" {
+			return false
+		}
+
+		filter := p.filter
+		if p.fetched {
+			filter = pageQuery(p.next)
+		}
+
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		fetchCtx, cancel := withBaseContext(ctx, p.ctx)
+		n, next, err := p.fetch(fetchCtx, filter)
+		cancel()
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.fetched = true
+		p.size = n
+		p.pos = 0
+		p.next = next
+	}
+
+	return true
+}
+
+// withBaseContext returns a context derived from ctx that is also cancelled
+// once the base context is done
+func withBaseContext(ctx, base context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if base.Done() == nil {
+		return ctx, cancel
+	}
+
+	go func() {
+		select {
+		case <-base.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Err returns the error, if any, that stopped the iteration
+func (p *pager) Err() error {
+	return p.err
+}
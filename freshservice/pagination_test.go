@@ -0,0 +1,103 @@
+package freshservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func ticketPages(t *testing.T, srvURL *string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v2/tickets?page=2&per_page=2>; rel="next"`, *srvURL))
+			fmt.Fprint(w, `{"tickets":[{"id":1},{"id":2}]}`)
+		case "2":
+			assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+			fmt.Fprint(w, `{"tickets":[{"id":3}]}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}
+}
+
+func TestTicketListAll(t *testing.T) {
+	var srvURL string
+	c, srv := newTestClient(t, ticketPages(t, &srvURL))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	ctx := context.Background()
+	iter := c.Tickets().ListAll(ctx, &freshservice.TicketListOptions{PerPage: 2})
+
+	var ids []int
+	for iter.Next(ctx) {
+		ids = append(ids, iter.Value().ID)
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.False(t, iter.Next(ctx))
+}
+
+func TestTicketListAllCollect(t *testing.T) {
+	var srvURL string
+	c, srv := newTestClient(t, ticketPages(t, &srvURL))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	ctx := context.Background()
+	list, err := c.Tickets().ListAll(ctx, &freshservice.TicketListOptions{PerPage: 2}).Collect(ctx, 2)
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+
+	list, err = c.Tickets().ListAll(ctx, &freshservice.TicketListOptions{PerPage: 2}).Collect(ctx, 0)
+	assert.Nil(t, err)
+	assert.Len(t, list, 3)
+}
+
+func TestListAllError(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	iter := c.Agents().ListAll(ctx, nil)
+	assert.False(t, iter.Next(ctx))
+	assert.True(t, errors.Is(iter.Err(), freshservice.ErrUnauthorized))
+}
+
+func TestListAllContextCancelled(t *testing.T) {
+	var srvURL string
+	requests := 0
+	pages := ticketPages(t, &srvURL)
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		pages(w, r)
+	})
+	defer srv.Close()
+	srvURL = srv.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	iter := c.Tickets().ListAll(ctx, &freshservice.TicketListOptions{PerPage: 2})
+
+	list, err := iter.Collect(context.Background(), 2)
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+
+	cancel()
+	assert.False(t, iter.Next(context.Background()))
+	assert.True(t, errors.Is(iter.Err(), context.Canceled))
+	assert.Equal(t, 1, requests)
+}
+
+func TestPerPageCapped(t *testing.T) {
+	opts := &freshservice.AssetListOptions{PerPage: 250}
+	assert.Equal(t, "per_page=100", opts.QueryString())
+}
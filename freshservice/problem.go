@@ -0,0 +1,240 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const problemURL = "/api/v2/problems"
+
+// ProblemService is an interface for interacting with
+// the problem endpoints of the Freshservice API
+type ProblemService interface {
+	List(context.Context, QueryFilter) ([]ProblemDetails, string, error)
+	ListAll(context.Context, *ProblemListOptions) *ProblemIterator
+	Create(context.Context, *ProblemDetails) (*ProblemDetails, error)
+	Get(context.Context, int) (*ProblemDetails, error)
+	Update(context.Context, int, *ProblemDetails) (*ProblemDetails, error)
+	Delete(context.Context, int) error
+	AssociateTickets(context.Context, int, ...int) error
+	Notes() NoteService
+	Tasks() TaskService
+	TimeEntries() TimeEntryService
+}
+
+// ProblemServiceClient facilitates requests with the ProblemService methods
+type ProblemServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice problems
+func (p *ProblemServiceClient) List(ctx context.Context, filter QueryFilter) ([]ProblemDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   p.client.Domain,
+		Path:   problemURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Problems{}
+	resp, err := p.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice problem matching the
+// options passed in, requesting additional pages as the iterator advances
+func (p *ProblemServiceClient) ListAll(ctx context.Context, opts *ProblemListOptions) *ProblemIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &ProblemIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := p.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// ProblemIterator iterates over a paginated list of Freshservice problems
+type ProblemIterator struct {
+	pager
+	page []ProblemDetails
+}
+
+// Value returns the problem the iterator currently points at
+func (it *ProblemIterator) Value() ProblemDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max problems.
+// A max of zero or less will collect every remaining problem.
+func (it *ProblemIterator) Collect(ctx context.Context, max int) ([]ProblemDetails, error) {
+	var list []ProblemDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Create a new Freshservice problem
+func (p *ProblemServiceClient) Create(ctx context.Context, pd *ProblemDetails) (*ProblemDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   p.client.Domain,
+		Path:   problemURL,
+	}
+
+	problemContent, err := json.Marshal(pd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(problemContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Problem{}
+	if _, err := p.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific Freshservice problem
+func (p *ProblemServiceClient) Get(ctx context.Context, id int) (*ProblemDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   p.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", problemURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Problem{}
+	if _, err := p.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice problem
+func (p *ProblemServiceClient) Update(ctx context.Context, id int, pd *ProblemDetails) (*ProblemDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   p.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", problemURL, id),
+	}
+
+	problemContent, err := json.Marshal(pd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(problemContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Problem{}
+	if _, err := p.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice problem
+func (p *ProblemServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   p.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", problemURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := p.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AssociateTickets associates existing tickets with a problem by updating each ticket.
+// Tickets are updated in order and the first failure is returned along with the
+// ID of the ticket that could not be associated.
+func (p *ProblemServiceClient) AssociateTickets(ctx context.Context, id int, ticketIDs ...int) error {
+	assoc := &problemAssociation{}
+	assoc.Problem.DisplayID = id
+
+	assocContent, err := json.Marshal(assoc)
+	if err != nil {
+		return err
+	}
+
+	for _, tickID := range ticketIDs {
+		url := &url.URL{
+			Scheme: "https",
+			Host:   p.client.Domain,
+			Path:   fmt.Sprintf("%s/%d", ticketURL, tickID),
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), bytes.NewReader(assocContent))
+		if err != nil {
+			return err
+		}
+
+		if _, err := p.client.makeRequest(req, nil); err != nil {
+			return fmt.Errorf("associating ticket %d with problem %d: %w", tickID, id, err)
+		}
+	}
+
+	return nil
+}
+
+// Notes is the interface to the notes of a Freshservice problem
+func (p *ProblemServiceClient) Notes() NoteService {
+	return &NoteServiceClient{client: p.client, parentURL: problemURL}
+}
+
+// Tasks is the interface to the tasks of a Freshservice problem
+func (p *ProblemServiceClient) Tasks() TaskService {
+	return &TaskServiceClient{client: p.client, parentURL: problemURL}
+}
+
+// TimeEntries is the interface to the time entries of a Freshservice problem
+func (p *ProblemServiceClient) TimeEntries() TimeEntryService {
+	return &TimeEntryServiceClient{client: p.client, parentURL: problemURL}
+}
@@ -0,0 +1,88 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// ProblemOpen is the value required to indicate a problem status is open
+	ProblemOpen = 1
+	// ProblemChangeRequested is the value required to indicate a change has been requested for a problem
+	ProblemChangeRequested = 2
+	// ProblemClosed is the value required to indicate a problem status is closed
+	ProblemClosed = 3
+)
+
+// Problems holds a list of Freshservice problems
+type Problems struct {
+	List []ProblemDetails `json:"problems"`
+}
+
+// Problem holds the details of a specific Freshservice problem
+type Problem struct {
+	Details ProblemDetails `json:"problem"`
+}
+
+// ProblemDetails contains the details of a specific Freshservice problem
+type ProblemDetails struct {
+	ID              int                    `json:"id,omitempty"` // Read-Only
+	RequesterID     int                    `json:"requester_id,omitempty"`
+	Email           string                 `json:"email,omitempty"`
+	AgentID         int                    `json:"agent_id,omitempty"`
+	GroupID         int                    `json:"group_id,omitempty"`
+	DepartmentID    int                    `json:"department_id,omitempty"`
+	Subject         string                 `json:"subject,omitempty"`
+	Description     string                 `json:"description,omitempty"`
+	DescriptionText string                 `json:"description_text,omitempty"` // Read-Only
+	Priority        int                    `json:"priority,omitempty"`
+	Impact          int                    `json:"impact,omitempty"`
+	Status          int                    `json:"status,omitempty"`
+	KnownError      *bool                  `json:"known_error,omitempty"`
+	DueBy           *time.Time             `json:"due_by,omitempty"`
+	Category        string                 `json:"category,omitempty"`
+	SubCategory     string                 `json:"sub_category,omitempty"`
+	ItemCategory    string                 `json:"item_category,omitempty"`
+	AnalysisFields  *ProblemAnalysisFields `json:"analysis_fields,omitempty"`
+	Assets          []AssetReference       `json:"assets,omitempty"`
+	CustomFields    CustomFields           `json:"custom_fields,omitempty"`
+	CreatedAt       *time.Time             `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt       *time.Time             `json:"updated_at,omitempty"` // Read-Only
+}
+
+// ProblemAnalysisFields holds the root cause analysis of a Freshservice problem
+type ProblemAnalysisFields struct {
+	ProblemCause      *PlanningField `json:"problem_cause,omitempty"`
+	ProblemSymptom    *PlanningField `json:"problem_symptom,omitempty"`
+	ProblemImpact     *PlanningField `json:"problem_impact,omitempty"`
+	Workaround        *PlanningField `json:"workaround,omitempty"`
+	PermanentSolution *PlanningField `json:"permanent_solution,omitempty"`
+}
+
+// ProblemListOptions holds the available options that can be
+// passed when requesting a list of Freshservice problems
+type ProblemListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *ProblemListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
+
+// problemAssociation is the ticket payload used to associate a ticket with a problem
+type problemAssociation struct {
+	Problem struct {
+		DisplayID int `json:"display_id"`
+	} `json:"problem"`
+}
@@ -0,0 +1,52 @@
+package freshservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestProblemAssociateTickets(t *testing.T) {
+	var paths []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"problem":{"display_id":3}}`, string(body))
+
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/api/v2/tickets/12" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"ticket":{}}`))
+	})
+	defer srv.Close()
+
+	err := c.Problems().AssociateTickets(context.Background(), 3, 10, 11)
+	assert.Nil(t, err)
+
+	err = c.Problems().AssociateTickets(context.Background(), 3, 12, 13)
+	assert.True(t, errors.Is(err, freshservice.ErrNotFound))
+	assert.Equal(t, []string{"/api/v2/tickets/10", "/api/v2/tickets/11", "/api/v2/tickets/12"}, paths)
+}
+
+func TestProblemClearKnownError(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v2/problems/3", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"known_error":false}`, string(body))
+		fmt.Fprint(w, `{"problem":{"id":3,"known_error":false}}`)
+	})
+	defer srv.Close()
+
+	pd, err := c.Problems().Update(context.Background(), 3, &freshservice.ProblemDetails{KnownError: freshservice.Bool(false)})
+	assert.Nil(t, err)
+	assert.False(t, *pd.KnownError)
+}
@@ -0,0 +1,217 @@
+package freshservice
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxQueryLength is the longest filter query accepted by the Freshservice API
+const MaxQueryLength = 512
+
+var queryFieldPattern = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// Query builds a filter query in the Freshservice query language for the
+// endpoints that accept a query parameter, e.g. tickets, requesters and agents.
+// Terms added to a query are joined with AND; use And and Or to combine queries.
+//
+//	fs.Q().Eq("priority", fs.UrgentPriority).Eq("status", fs.TicketOpen).
+//		Or(fs.Q().Eq("group_id", 123).Gte("created_at", since))
+type Query struct {
+	expr   string
+	op     string
+	fields []string
+	err    error
+}
+
+// Q returns a new empty filter query
+func Q() *Query {
+	return &Query{}
+}
+
+// Eq adds a term matching a field to a value. Strings are quoted, time.Time
+// values are formatted as dates and a nil value matches an empty field.
+// Custom fields are referenced by their name, see Custom.
+func (q *Query) Eq(field string, value interface{}) *Query {
+	return q.term(field, "", value)
+}
+
+// Custom adds a term matching a custom field, by the name shown in the
+// custom_fields of an entity, to a value
+func (q *Query) Custom(field string, value interface{}) *Query {
+	return q.term(field, "", value)
+}
+
+// Gte adds a term matching a field greater than or equal to a value
+func (q *Query) Gte(field string, value interface{}) *Query {
+	return q.term(field, ">", value)
+}
+
+// Lte adds a term matching a field less than or equal to a value
+func (q *Query) Lte(field string, value interface{}) *Query {
+	return q.term(field, "<", value)
+}
+
+// Between adds a term matching a field within an inclusive range of values
+func (q *Query) Between(field string, from interface{}, to interface{}) *Query {
+	return q.And(Q().Gte(field, from).Lte(field, to))
+}
+
+// In adds a term matching a field to any of the values passed in
+func (q *Query) In(field string, values ...interface{}) *Query {
+	if len(values) == 0 {
+		q.setErr(fmt.Errorf("query term %s requires at least one value", field))
+		return q
+	}
+
+	terms := make([]*Query, len(values))
+	for i, v := range values {
+		terms[i] = Q().Eq(field, v)
+	}
+	return q.And(Q().Or(terms...))
+}
+
+// And joins the queries passed in to this query with AND
+func (q *Query) And(others ...*Query) *Query {
+	return q.combine("AND", others)
+}
+
+// Or joins the queries passed in to this query with OR
+func (q *Query) Or(others ...*Query) *Query {
+	return q.combine("OR", others)
+}
+
+// String returns the query expression without the surrounding quotes
+func (q *Query) String() string {
+	return q.expr
+}
+
+// Fields returns the names of the fields referenced by the query
+func (q *Query) Fields() []string {
+	return q.fields
+}
+
+// Validate returns any error encountered while building the query
+// or if the query exceeds the length Freshservice allows
+func (q *Query) Validate() error {
+	if q.err != nil {
+		return q.err
+	}
+
+	if q.expr == "" {
+		return errors.New("query must contain at least one term")
+	}
+
+	if len(q.expr)+2 > MaxQueryLength {
+		return fmt.Errorf("query is %d characters long, the maximum is %d", len(q.expr)+2, MaxQueryLength)
+	}
+
+	return nil
+}
+
+// QueryString allows a Query to meet the QueryFilter interface. The expression is
+// quoted and encoded as the query parameter; call Validate to check the query first.
+func (q *Query) QueryString() string {
+	return q.param("query")
+}
+
+// param returns the quoted and encoded expression as the named query parameter
+func (q *Query) param(name string) string {
+	// spaces are encoded as %20 since Freshservice does not treat + as a space within a query
+	return name + "=" + strings.Replace(url.QueryEscape(`"`+q.expr+`"`), "+", "%20", -1)
+}
+
+// validateFields checks that only the fields passed in are referenced by the query
+func (q *Query) validateFields(allowed ...string) error {
+	for _, f := range q.fields {
+		if !StringInSlice(f, allowed) {
+			return fmt.Errorf("query field %s is not supported; choose from %s", f, strings.Join(allowed, ","))
+		}
+	}
+	return nil
+}
+
+func (q *Query) term(field string, cmp string, value interface{}) *Query {
+	if !queryFieldPattern.MatchString(field) {
+		q.setErr(fmt.Errorf("query field %q is invalid", field))
+		return q
+	}
+
+	v, err := formatQueryValue(value)
+	if err != nil {
+		q.setErr(fmt.Errorf("query field %s: %w", field, err))
+		return q
+	}
+
+	return q.And(&Query{expr: fmt.Sprintf("%s:%s%s", field, cmp, v), fields: []string{field}})
+}
+
+func (q *Query) combine(op string, others []*Query) *Query {
+	var parts []string
+	for _, o := range append([]*Query{q}, others...) {
+		if o.err != nil {
+			q.setErr(o.err)
+		}
+		if o != q {
+			for _, f := range o.fields {
+				if !StringInSlice(f, q.fields) {
+					q.fields = append(q.fields, f)
+				}
+			}
+		}
+
+		switch {
+		case o.expr == "":
+		case o.op != "" && o.op != op:
+			parts = append(parts, "("+o.expr+")")
+		default:
+			parts = append(parts, o.expr)
+		}
+	}
+
+	q.expr = strings.Join(parts, " "+op+" ")
+	if len(parts) > 1 {
+		q.op = op
+	}
+	return q
+}
+
+func (q *Query) setErr(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// formatQueryValue quotes a value as expected by the Freshservice query language
+func formatQueryValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		if strings.ContainsAny(v, `'"`) {
+			return "", fmt.Errorf("value %s can not contain quotes", v)
+		}
+		return fmt.Sprintf("'%s'", v), nil
+	case *string:
+		if v == nil {
+			return "null", nil
+		}
+		return formatQueryValue(*v)
+	case *int:
+		if v == nil {
+			return "null", nil
+		}
+		return fmt.Sprintf("%d", *v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	case time.Time:
+		return fmt.Sprintf("'%s'", v.Format("2006-01-02")), nil
+	}
+
+	return "", fmt.Errorf("unsupported value type %T", value)
+}
@@ -0,0 +1,97 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Query    *freshservice.Query
+		Expected string
+	}{
+		{
+			Query:    freshservice.Q().Eq("priority", 4).Eq("status", 2),
+			Expected: "priority:4 AND status:2",
+		},
+		{
+			Query:    freshservice.Q().Eq("priority", 4).And(freshservice.Q().Eq("group_id", 123)).Gte("created_at", since),
+			Expected: "priority:4 AND group_id:123 AND created_at:>'2026-01-01'",
+		},
+		{
+			Query:    freshservice.Q().Eq("priority", 4).Or(freshservice.Q().Eq("status", 2).Eq("agent_id", nil)),
+			Expected: "priority:4 OR (status:2 AND agent_id:null)",
+		},
+		{
+			Query:    freshservice.Q().Eq("status", 2).In("priority", 3, 4),
+			Expected: "status:2 AND (priority:3 OR priority:4)",
+		},
+		{
+			Query:    freshservice.Q().Custom("cost_center", "ENG 42").Between("due_by", since, since.AddDate(0, 1, 0)),
+			Expected: "cost_center:'ENG 42' AND due_by:>'2026-01-01' AND due_by:<'2026-02-01'",
+		},
+	}
+
+	for _, c := range cases {
+		assert.Nil(t, c.Query.Validate())
+		assert.Equal(t, c.Expected, c.Query.String())
+	}
+}
+
+func TestQueryEncoding(t *testing.T) {
+	q := freshservice.Q().Eq("priority", 4).Eq("email", "a+b@example.com")
+	assert.Equal(t, "query=%22priority%3A4%20AND%20email%3A%27a%2Bb%40example.com%27%22", q.QueryString())
+}
+
+func TestQueryValidate(t *testing.T) {
+	assert.NotNil(t, freshservice.Q().Validate())
+	assert.NotNil(t, freshservice.Q().Eq("name", "O'Brien").Validate())
+	assert.NotNil(t, freshservice.Q().Eq("bad field", 1).Validate())
+	assert.NotNil(t, freshservice.Q().Eq("priority", 1.5).Validate())
+	assert.NotNil(t, freshservice.Q().Eq("subject", strings.Repeat("a", freshservice.MaxQueryLength)).Validate())
+}
+
+func TestTicketFilter(t *testing.T) {
+	pages := 0
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		assert.Equal(t, "/api/v2/tickets/filter", r.URL.Path)
+		assert.Equal(t, `"priority:4 AND status:2"`, r.URL.Query().Get("query"))
+		assert.Equal(t, fmt.Sprint(pages), r.URL.Query().Get("page"))
+
+		// every page is full so paging stops at the 10 page limit
+		tickets := make([]string, 30)
+		for i := range tickets {
+			tickets[i] = fmt.Sprintf(`{"id":%d}`, (pages-1)*30+i)
+		}
+		fmt.Fprintf(w, `{"tickets":[%s]}`, strings.Join(tickets, ","))
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	list, err := c.Tickets().Filter(ctx, freshservice.Q().Eq("priority", 4).Eq("status", 2)).Collect(ctx, 0)
+	assert.Nil(t, err)
+	assert.Len(t, list, 300)
+	assert.Equal(t, 10, pages)
+}
+
+func TestTicketFilterInvalidQuery(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request should be made for an invalid query")
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	iter := c.Tickets().Filter(ctx, freshservice.Q())
+	assert.False(t, iter.Next(ctx))
+	assert.NotNil(t, iter.Err())
+}
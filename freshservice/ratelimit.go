@@ -0,0 +1,112 @@
+package freshservice
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Freshservice allots API credits per minute
+const rateLimitWindow = time.Minute
+
+// RateLimit holds the rate limit state last reported by Freshservice
+// through the X-Ratelimit-* response headers
+type RateLimit struct {
+	// Total is the number of credits allotted per minute
+	Total int
+	// Remaining is the number of credits left in the current minute
+	Remaining int
+	// UsedCurrentRequest is the number of credits consumed by the last request
+	UsedCurrentRequest int
+	// UpdatedAt is when the headers were last seen; zero if never
+	UpdatedAt time.Time
+}
+
+// parseRateLimit pulls the rate limit headers out of a Freshservice response
+func parseRateLimit(res *http.Response) (RateLimit, bool) {
+	total, err := strconv.Atoi(res.Header.Get("X-Ratelimit-Total"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	remaining, err := strconv.Atoi(res.Header.Get("X-Ratelimit-Remaining"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	used, _ := strconv.Atoi(res.Header.Get("X-Ratelimit-Used-CurrentRequest"))
+
+	return RateLimit{
+		Total:              total,
+		Remaining:          remaining,
+		UsedCurrentRequest: used,
+		UpdatedAt:          time.Now(),
+	}, true
+}
+
+// RateLimiter is a client side token bucket that is kept in sync with the credits
+// reported by Freshservice. Requests pass through untouched while plenty of
+// credits remain; once the remaining credits drop below Threshold requests are
+// paced at the rate credits are replenished instead of failing with a 429.
+type RateLimiter struct {
+	// Threshold is the number of remaining credits at which pacing kicks in
+	Threshold int
+
+	mu     sync.Mutex
+	tokens float64
+	rate   float64 // credits replenished per second
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that begins pacing requests
+// once the remaining credits fall below the threshold passed in
+func NewRateLimiter(threshold int) *RateLimiter {
+	return &RateLimiter{Threshold: threshold}
+}
+
+// Wait blocks until a request may be made or the context is done. Until
+// Freshservice has reported its limits the limiter does not block.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	if l.rate == 0 {
+		l.mu.Unlock()
+		return nil
+	}
+
+	l.refill(time.Now())
+	l.tokens--
+
+	var d time.Duration
+	if deficit := float64(l.Threshold) - l.tokens; deficit > 0 {
+		d = time.Duration(deficit / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	return sleepContext(ctx, d)
+}
+
+// Update syncs the bucket with the rate limit reported by Freshservice
+func (l *RateLimiter) Update(rl RateLimit) {
+	if rl.Total <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = float64(rl.Total) / rateLimitWindow.Seconds()
+	l.tokens = float64(rl.Remaining)
+	l.last = rl.UpdatedAt
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.last).Seconds(); elapsed > 0 {
+		l.tokens += elapsed * l.rate
+		if max := l.rate * rateLimitWindow.Seconds(); l.tokens > max {
+			l.tokens = max
+		}
+	}
+	l.last = now
+}
@@ -0,0 +1,65 @@
+package freshservice
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterPassThrough(t *testing.T) {
+	l := NewRateLimiter(10)
+	assert.Nil(t, l.Wait(context.Background()))
+
+	l.Update(RateLimit{Total: 60, Remaining: 50, UpdatedAt: time.Now()})
+	start := time.Now()
+	assert.Nil(t, l.Wait(context.Background()))
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+}
+
+func TestRateLimiterPacesBelowThreshold(t *testing.T) {
+	l := NewRateLimiter(10)
+	l.Update(RateLimit{Total: 60, Remaining: 5, UpdatedAt: time.Now()})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+}
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	p := &BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	get, _ := http.NewRequest(http.MethodGet, "https://domain.freshservice.com", nil)
+	post, _ := http.NewRequest(http.MethodPost, "https://domain.freshservice.com", nil)
+
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	limited.Header.Set("Retry-After", "30")
+	d, ok := p.Retry(1, post, limited, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, d)
+
+	unavailable := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	d, ok = p.Retry(2, get, unavailable, nil)
+	assert.True(t, ok)
+	assert.True(t, d >= time.Second && d <= 2*time.Second)
+
+	_, ok = p.Retry(1, post, unavailable, nil)
+	assert.False(t, ok)
+
+	_, ok = p.Retry(3, get, unavailable, nil)
+	assert.False(t, ok)
+
+	_, ok = p.Retry(1, get, &http.Response{StatusCode: http.StatusBadRequest}, nil)
+	assert.False(t, ok)
+}
+
+func TestBackoffRetryPolicyUncapped(t *testing.T) {
+	p := &BackoffRetryPolicy{MaxAttempts: 4, BaseDelay: time.Second}
+	get, _ := http.NewRequest(http.MethodGet, "https://domain.freshservice.com", nil)
+
+	unavailable := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	d, ok := p.Retry(3, get, unavailable, nil)
+	assert.True(t, ok)
+	assert.True(t, d >= 2*time.Second && d <= 4*time.Second)
+}
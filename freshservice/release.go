@@ -0,0 +1,214 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const releaseURL = "/api/v2/releases"
+
+// ReleaseService is an interface for interacting with
+// the release endpoints of the Freshservice API
+type ReleaseService interface {
+	List(context.Context, QueryFilter) ([]ReleaseDetails, string, error)
+	ListAll(context.Context, *ReleaseListOptions) *ReleaseIterator
+	Create(context.Context, *ReleaseDetails) (*ReleaseDetails, error)
+	Get(context.Context, int) (*ReleaseDetails, error)
+	Update(context.Context, int, *ReleaseDetails) (*ReleaseDetails, error)
+	Delete(context.Context, int) error
+	Notes() NoteService
+	Tasks() TaskService
+	TimeEntries() TimeEntryService
+	Approvals() ApprovalService
+}
+
+// ReleaseServiceClient facilitates requests with the ReleaseService methods
+type ReleaseServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice releases
+func (rs *ReleaseServiceClient) List(ctx context.Context, filter QueryFilter) ([]ReleaseDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   releaseURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Releases{}
+	resp, err := rs.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice release matching the
+// options passed in, requesting additional pages as the iterator advances
+func (rs *ReleaseServiceClient) ListAll(ctx context.Context, opts *ReleaseListOptions) *ReleaseIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &ReleaseIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := rs.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// ReleaseIterator iterates over a paginated list of Freshservice releases
+type ReleaseIterator struct {
+	pager
+	page []ReleaseDetails
+}
+
+// Value returns the release the iterator currently points at
+func (it *ReleaseIterator) Value() ReleaseDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max releases.
+// A max of zero or less will collect every remaining release.
+func (it *ReleaseIterator) Collect(ctx context.Context, max int) ([]ReleaseDetails, error) {
+	var list []ReleaseDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Create a new Freshservice release. Changes can be grouped into the
+// release by setting their IDs as the AssociatedChanges.
+func (rs *ReleaseServiceClient) Create(ctx context.Context, rd *ReleaseDetails) (*ReleaseDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   releaseURL,
+	}
+
+	releaseContent, err := json.Marshal(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(releaseContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Release{}
+	if _, err := rs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific Freshservice release
+func (rs *ReleaseServiceClient) Get(ctx context.Context, id int) (*ReleaseDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", releaseURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Release{}
+	if _, err := rs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a Freshservice release, e.g. to advance its Status
+func (rs *ReleaseServiceClient) Update(ctx context.Context, id int, rd *ReleaseDetails) (*ReleaseDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", releaseURL, id),
+	}
+
+	releaseContent, err := json.Marshal(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(releaseContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Release{}
+	if _, err := rs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a Freshservice release
+func (rs *ReleaseServiceClient) Delete(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", releaseURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := rs.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Notes is the interface to the notes of a Freshservice release
+func (rs *ReleaseServiceClient) Notes() NoteService {
+	return &NoteServiceClient{client: rs.client, parentURL: releaseURL}
+}
+
+// Tasks is the interface to the tasks of a Freshservice release
+func (rs *ReleaseServiceClient) Tasks() TaskService {
+	return &TaskServiceClient{client: rs.client, parentURL: releaseURL}
+}
+
+// TimeEntries is the interface to the time entries of a Freshservice release
+func (rs *ReleaseServiceClient) TimeEntries() TimeEntryService {
+	return &TimeEntryServiceClient{client: rs.client, parentURL: releaseURL}
+}
+
+// Approvals is the interface to the approvals of a Freshservice release
+func (rs *ReleaseServiceClient) Approvals() ApprovalService {
+	return &ApprovalServiceClient{client: rs.client, parentURL: releaseURL}
+}
@@ -0,0 +1,92 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+// Releases use the same priority values as tickets, e.g. HighPriority
+const (
+	// ReleaseOpen is the value required to indicate a release status is open
+	ReleaseOpen = 1
+	// ReleaseOnHold is the value required to indicate a release is on hold
+	ReleaseOnHold = 2
+	// ReleaseInProgress is the value required to indicate a release is in progress
+	ReleaseInProgress = 3
+	// ReleaseIncomplete is the value required to indicate a release is incomplete
+	ReleaseIncomplete = 4
+	// ReleaseCompleted is the value required to indicate a release is completed
+	ReleaseCompleted = 5
+	// ReleaseTypeMinor is the value to set a release type to minor
+	ReleaseTypeMinor = 1
+	// ReleaseTypeStandard is the value to set a release type to standard
+	ReleaseTypeStandard = 2
+	// ReleaseTypeMajor is the value to set a release type to major
+	ReleaseTypeMajor = 3
+	// ReleaseTypeEmergency is the value to set a release type to emergency
+	ReleaseTypeEmergency = 4
+)
+
+// Releases holds a list of Freshservice releases
+type Releases struct {
+	List []ReleaseDetails `json:"releases"`
+}
+
+// Release holds the details of a specific Freshservice release
+type Release struct {
+	Details ReleaseDetails `json:"release"`
+}
+
+// ReleaseDetails contains the details of a specific Freshservice release
+type ReleaseDetails struct {
+	ID                int                    `json:"id,omitempty"` // Read-Only
+	AgentID           int                    `json:"agent_id,omitempty"`
+	GroupID           int                    `json:"group_id,omitempty"`
+	DepartmentID      int                    `json:"department_id,omitempty"`
+	Subject           string                 `json:"subject,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	DescriptionText   string                 `json:"description_text,omitempty"` // Read-Only
+	Priority          int                    `json:"priority,omitempty"`
+	Status            int                    `json:"status,omitempty"`
+	ReleaseType       int                    `json:"release_type,omitempty"`
+	PlannedStartDate  *time.Time             `json:"planned_start_date,omitempty"`
+	PlannedEndDate    *time.Time             `json:"planned_end_date,omitempty"`
+	WorkStartDate     *time.Time             `json:"work_start_date,omitempty"`
+	WorkEndDate       *time.Time             `json:"work_end_date,omitempty"`
+	Category          string                 `json:"category,omitempty"`
+	SubCategory       string                 `json:"sub_category,omitempty"`
+	ItemCategory      string                 `json:"item_category,omitempty"`
+	AssociatedChanges []int                  `json:"associated_changes,omitempty"`
+	Assets            []AssetReference       `json:"assets,omitempty"`
+	PlanningFields    *ReleasePlanningFields `json:"planning_fields,omitempty"`
+	CustomFields      CustomFields           `json:"custom_fields,omitempty"`
+	CreatedAt         *time.Time             `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt         *time.Time             `json:"updated_at,omitempty"` // Read-Only
+}
+
+// ReleasePlanningFields holds the planning details of a Freshservice release
+type ReleasePlanningFields struct {
+	BuildPlan *PlanningField `json:"build_plan,omitempty"`
+	TestPlan  *PlanningField `json:"test_plan,omitempty"`
+}
+
+// ReleaseListOptions holds the available options that can be
+// passed when requesting a list of Freshservice releases
+type ReleaseListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *ReleaseListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
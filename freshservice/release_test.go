@@ -0,0 +1,122 @@
+package freshservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestReleaseCreate(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/releases", r.URL.Path)
+
+		body := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Quarterly release", body["subject"])
+		assert.Equal(t, float64(freshservice.ReleaseTypeMajor), body["release_type"])
+		assert.Equal(t, "2026-10-18T10:00:00Z", body["planned_start_date"])
+		assert.Equal(t, []interface{}{float64(9), float64(10)}, body["associated_changes"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"display_id": float64(3)}}, body["assets"])
+		assert.Equal(t, map[string]interface{}{
+			"build_plan": map[string]interface{}{"description": "Tag and build"},
+		}, body["planning_fields"])
+		assert.NotContains(t, body, "id")
+		assert.NotContains(t, body, "created_at")
+
+		fmt.Fprint(w, `{"release":{"id":4,"subject":"Quarterly release","status":1}}`)
+	})
+	defer srv.Close()
+
+	start := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	rd, err := c.Releases().Create(context.Background(), &freshservice.ReleaseDetails{
+		Subject:           "Quarterly release",
+		ReleaseType:       freshservice.ReleaseTypeMajor,
+		PlannedStartDate:  &start,
+		AssociatedChanges: []int{9, 10},
+		Assets:            []freshservice.AssetReference{{DisplayID: 3}},
+		PlanningFields: &freshservice.ReleasePlanningFields{
+			BuildPlan: &freshservice.PlanningField{Description: "Tag and build"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, rd.ID)
+	assert.Equal(t, freshservice.ReleaseOpen, rd.Status)
+}
+
+func TestReleaseUpdate(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v2/releases/4", r.URL.Path)
+
+		body := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"status": float64(freshservice.ReleaseCompleted)}, body)
+
+		fmt.Fprint(w, `{"release":{"id":4,"status":5}}`)
+	})
+	defer srv.Close()
+
+	rd, err := c.Releases().Update(context.Background(), 4, &freshservice.ReleaseDetails{
+		Status: freshservice.ReleaseCompleted,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, freshservice.ReleaseCompleted, rd.Status)
+}
+
+func TestReleaseList(t *testing.T) {
+	var queries []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/releases", r.URL.Path)
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<https://%s/api/v2/releases?page=2&per_page=1>; rel="next"`, r.Host))
+			fmt.Fprint(w, `{"releases":[{"id":4}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"releases":[{"id":5}]}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	list, next, err := c.Releases().List(ctx, &freshservice.ReleaseListOptions{PerPage: 1})
+	assert.Nil(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "page=2&per_page=1", next)
+
+	all, err := c.Releases().ListAll(ctx, &freshservice.ReleaseListOptions{PerPage: 1}).Collect(ctx, 0)
+	assert.Nil(t, err)
+	assert.Len(t, all, 2)
+	assert.Equal(t, 5, all[1].ID)
+
+	assert.Equal(t, []string{"per_page=1", "per_page=1", "page=2&per_page=1"}, queries)
+}
+
+func TestReleaseSubResources(t *testing.T) {
+	var paths []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	_, err := c.Releases().Notes().Get(ctx, 4, 1)
+	assert.Nil(t, err)
+	_, err = c.Releases().Tasks().Get(ctx, 4, 2)
+	assert.Nil(t, err)
+	_, err = c.Releases().TimeEntries().Get(ctx, 4, 3)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{
+		"/api/v2/releases/4/notes/1",
+		"/api/v2/releases/4/tasks/2",
+		"/api/v2/releases/4/time_entries/3",
+	}, paths)
+}
@@ -0,0 +1,44 @@
+package freshservice
+
+import "time"
+
+const (
+	// RequestedItemRequested is the stage of an item that has been requested
+	RequestedItemRequested = 1
+	// RequestedItemDelivered is the stage of an item that has been delivered
+	RequestedItemDelivered = 2
+	// RequestedItemCancelled is the stage of an item whose request was cancelled
+	RequestedItemCancelled = 3
+	// RequestedItemFulfilled is the stage of an item that has been fulfilled
+	RequestedItemFulfilled = 4
+	// RequestedItemPartiallyFulfilled is the stage of an item that has been partially fulfilled
+	RequestedItemPartiallyFulfilled = 5
+)
+
+// RequestedItems holds a list of items requested on a Freshservice service request
+type RequestedItems struct {
+	List []RequestedItemDetails `json:"requested_items"`
+}
+
+// RequestedItem holds the details of a specific item requested on a service request
+type RequestedItem struct {
+	Details RequestedItemDetails `json:"requested_item"`
+}
+
+// RequestedItemDetails are the details of a service catalog item requested
+// on a service request ticket
+type RequestedItemDetails struct {
+	ID              int          `json:"id,omitempty"` // Read-Only
+	ServiceItemID   int          `json:"service_item_id,omitempty"`
+	ServiceItemName string       `json:"service_item_name,omitempty"`
+	Quantity        int          `json:"quantity,omitempty"`
+	Stage           int          `json:"stage,omitempty"` // One of the RequestedItem* stages
+	Loaned          *bool        `json:"loaned,omitempty"`
+	CostPerRequest  float64      `json:"cost_per_request,omitempty"`
+	Remarks         string       `json:"remarks,omitempty"`
+	DeliveryTime    int          `json:"delivery_time,omitempty"`
+	IsParent        *bool        `json:"is_parent,omitempty"`
+	CustomFields    CustomFields `json:"custom_fields,omitempty"`
+	CreatedAt       *time.Time   `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt       *time.Time   `json:"updated_at,omitempty"` // Read-Only
+}
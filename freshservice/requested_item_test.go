@@ -0,0 +1,42 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestRequestedItems(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			assert.Equal(t, "/api/v2/tickets/301/requested_items", r.URL.Path)
+			fmt.Fprint(w, `{"requested_items":[{"id":7,"service_item_id":14,"service_item_name":"VPN access",
+				"quantity":1,"stage":1,"cost_per_request":12.5,"custom_fields":{"access_level":"read"}}]}`)
+			return
+		}
+		assert.Equal(t, "/api/v2/tickets/301/requested_items/7", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"stage":2,"remarks":"granted"}`, string(body))
+		fmt.Fprint(w, `{"requested_item":{"id":7,"stage":2,"remarks":"granted"}}`)
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	items, err := c.Tickets().RequestedItems(ctx, 301)
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "read", items[0].CustomFields["access_level"])
+	assert.Equal(t, 12.5, items[0].CostPerRequest)
+
+	item, err := c.Tickets().UpdateRequestedItem(ctx, 301, 7, &freshservice.RequestedItemDetails{
+		Stage:   freshservice.RequestedItemDelivered,
+		Remarks: "granted",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, freshservice.RequestedItemDelivered, item.Stage)
+}
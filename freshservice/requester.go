@@ -12,10 +12,13 @@ import (
 
 const RequesterURL = "/api/v2/requesters"
 
+const requesterFieldURL = "/api/v2/requester_fields"
+
 // RequesterService is an interface for interacting with
 // the Requester endpoints of the Freshservice API
 type RequesterService interface {
 	List(context.Context, QueryFilter) ([]RequesterDetails, string, error)
+	ListAll(context.Context, *RequesterListFilter) *RequesterIterator
 	Create(context.Context, *RequesterDetails) (*RequesterDetails, error)
 	Get(context.Context, int) (*RequesterDetails, error)
 	Update(context.Context, int, *RequesterDetails) (*RequesterDetails, error)
@@ -24,6 +27,7 @@ type RequesterService interface {
 	Reactivate(context.Context, int) (*RequesterDetails, error)
 	ConvertToAgent(context.Context, int) (*RequesterDetails, error)
 	MergeRequesters(context.Context, int, []int) (*RequesterDetails, error)
+	Fields(context.Context) ([]UserFieldDetails, error)
 }
 
 // RequesterServiceClient facilitates requests with the RequesterService methods
@@ -57,6 +61,44 @@ func (rs *RequesterServiceClient) List(ctx context.Context, filter QueryFilter)
 	return res.List, HasNextPage(resp), nil
 }
 
+// ListAll returns an iterator over every Freshservice requester matching the
+// options passed in, requesting additional pages as the iterator advances
+func (rs *RequesterServiceClient) ListAll(ctx context.Context, opts *RequesterListFilter) *RequesterIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &RequesterIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := rs.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// RequesterIterator iterates over a paginated list of Freshservice requesters
+type RequesterIterator struct {
+	pager
+	page []RequesterDetails
+}
+
+// Value returns the requester the iterator currently points at
+func (it *RequesterIterator) Value() RequesterDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max requesters.
+// A max of zero or less will collect every remaining requester.
+func (it *RequesterIterator) Collect(ctx context.Context, max int) ([]RequesterDetails, error) {
+	var list []RequesterDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
 // Get a specific Freshservice Requester
 func (rs *RequesterServiceClient) Get(ctx context.Context, id int) (*RequesterDetails, error) {
 	url := &url.URL{
@@ -251,3 +293,25 @@ func (rs *RequesterServiceClient) MergeRequesters(ctx context.Context, id int, s
 
 	return &res.Details, nil
 }
+
+// Fields returns the definitions of the default and custom requester fields,
+// including the choices of dropdown fields
+func (rs *RequesterServiceClient) Fields(ctx context.Context) ([]UserFieldDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   requesterFieldURL,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RequesterFields{}
+	if _, err := rs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
@@ -19,31 +19,29 @@ type Requester struct {
 // RequesterDetails contains the details of a specific Freshservice Requester
 
 type RequesterDetails struct {
-	ID                                        int      `json:"id"`
-	FirstName                                 string   `json:"first_name"`
-	LastName                                  string   `json:"last_name"`
-	JobTitle                                  string   `json:"job_title"`
-	PrimaryEmail                              string   `json:"primary_email"`
-	SecondaryEmails                           []string `json:"secondary_emails"`
-	WorkPhoneNumber                           string   `json:"work_phone_number"`
-	MobilePhoneNumber                         string   `json:"mobile_phone_number"`
-	DepartmentIDs                             []int    `json:"department_ids"`
-	CanSeeAllTicketsFromAssociatedDepartments bool     `json:"can_see_all_tickets_from_associated_departments"`
-	ReportingManagerID                        int      `json:"reporting_manager_id"`
-	Address                                   string   `json:"address"`
-	TimeZone                                  string   `json:"time_zone"`
-	TimeFormat                                string   `json:"time_format"`
-	Language                                  string   `json:"language"`
-	LocationID                                int      `json:"location_id"`
-	BackgroundInformation                     string   `json:"background_information"`
-	CustomFields                              struct {
-		House string `json:"house"`
-	} `json:"custom_fields"`
-	Active           bool      `json:"active"`
-	HasLoggedIn      bool      `json:"has_logged_in"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
-	IsRequesterGroup bool      `json:"is_Requester"`
+	ID                                        int          `json:"id"`
+	FirstName                                 string       `json:"first_name"`
+	LastName                                  string       `json:"last_name"`
+	JobTitle                                  string       `json:"job_title"`
+	PrimaryEmail                              string       `json:"primary_email"`
+	SecondaryEmails                           []string     `json:"secondary_emails"`
+	WorkPhoneNumber                           string       `json:"work_phone_number"`
+	MobilePhoneNumber                         string       `json:"mobile_phone_number"`
+	DepartmentIDs                             []int        `json:"department_ids"`
+	CanSeeAllTicketsFromAssociatedDepartments bool         `json:"can_see_all_tickets_from_associated_departments"`
+	ReportingManagerID                        int          `json:"reporting_manager_id"`
+	Address                                   string       `json:"address"`
+	TimeZone                                  string       `json:"time_zone"`
+	TimeFormat                                string       `json:"time_format"`
+	Language                                  string       `json:"language"`
+	LocationID                                int          `json:"location_id"`
+	BackgroundInformation                     string       `json:"background_information"`
+	CustomFields                              CustomFields `json:"custom_fields,omitempty"`
+	Active                                    bool         `json:"active"`
+	HasLoggedIn                               bool         `json:"has_logged_in"`
+	CreatedAt                                 time.Time    `json:"created_at"`
+	UpdatedAt                                 time.Time    `json:"updated_at"`
+	IsRequesterGroup                          bool         `json:"is_Requester"`
 }
 
 func (r *RequesterDetails) Validate() error {
@@ -62,6 +60,8 @@ func (r *RequesterDetails) Validate() error {
 // RequesterListFilter holds the filters available when listing Freservice Requesters
 type RequesterListFilter struct {
 	PageQuery     string
+	PerPage       int
+	Query         *Query
 	Email         *string
 	MobilePhone   *int
 	WorkPhone     *int
@@ -76,6 +76,14 @@ func (rf *RequesterListFilter) QueryString() string {
 		qs = append(qs, rf.PageQuery)
 	}
 
+	if rf.PerPage > 0 {
+		qs = append(qs, perPageQuery(rf.PerPage))
+	}
+
+	if rf.Query != nil {
+		qs = append(qs, rf.Query.QueryString())
+	}
+
 	switch {
 	case rf.Email != nil:
 		qs = append(qs, fmt.Sprintf("email=%s", *rf.Email))
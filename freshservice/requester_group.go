@@ -13,6 +13,7 @@ const requesterGroupURL = "/api/v2/requester_groups"
 
 type RequesterGroupService interface {
 	List(context.Context, QueryFilter) ([]RequesterGroupDetails, string, error)
+	ListAll(context.Context, *RequesterGroupListFilter) *RequesterGroupIterator
 	Create(context.Context, *RequesterGroupDetails) (*RequesterGroupDetails, error)
 	Get(context.Context, int) (*RequesterGroupDetails, error)
 	Update(context.Context, int, *RequesterGroupDetails) (*RequesterGroupDetails, error)
@@ -53,6 +54,44 @@ func (as *RequesterGroupServiceClient) List(ctx context.Context, filter QueryFil
 	return res.List, HasNextPage(resp), nil
 }
 
+// ListAll returns an iterator over every Freshservice requester group matching the
+// options passed in, requesting additional pages as the iterator advances
+func (as *RequesterGroupServiceClient) ListAll(ctx context.Context, opts *RequesterGroupListFilter) *RequesterGroupIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &RequesterGroupIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := as.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// RequesterGroupIterator iterates over a paginated list of Freshservice requester groups
+type RequesterGroupIterator struct {
+	pager
+	page []RequesterGroupDetails
+}
+
+// Value returns the requester group the iterator currently points at
+func (it *RequesterGroupIterator) Value() RequesterGroupDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max requester groups.
+// A max of zero or less will collect every remaining requester group.
+func (it *RequesterGroupIterator) Collect(ctx context.Context, max int) ([]RequesterGroupDetails, error) {
+	var list []RequesterGroupDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
 func (as *RequesterGroupServiceClient) Create(ctx context.Context, rg *RequesterGroupDetails) (*RequesterGroupDetails, error) {
 	url := &url.URL{
 		Scheme: "https",
@@ -38,14 +38,19 @@ func (rg *RequesterGroupDetails) Validate() error {
 // RequesterGroupListFilter holds the filters available when listing Freservice agents
 type RequesterGroupListFilter struct {
 	PageQuery string
+	PerPage   int
 }
 
 // QueryString allows the available filter items to meet the QueryFilter interface
 func (af *RequesterGroupListFilter) QueryString() string {
-
+	var qs []string
 	if af.PageQuery != "" {
-		return af.PageQuery
+		qs = append(qs, af.PageQuery)
+	}
+
+	if af.PerPage > 0 {
+		qs = append(qs, perPageQuery(af.PerPage))
 	}
 
-	return ""
+	return strings.Join(qs, "&")
 }
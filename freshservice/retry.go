@@ -0,0 +1,130 @@
+package freshservice
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides whether a failed request should be retried and how long
+// to wait before doing so. attempt starts at 1 for the initial request. Either
+// res or err will be set depending on whether a response was received.
+type RetryPolicy interface {
+	Retry(attempt int, req *http.Request, res *http.Response, err error) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy retries rate limited requests, server errors and network
+// failures using a jittered exponential backoff. A Retry-After header returned
+// by Freshservice always takes precedence over the computed backoff.
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the initial request
+	MaxAttempts int
+	// BaseDelay is the backoff used before the first retry, doubling after each attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff between attempts as well as any Retry-After value
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy a client is configured with by New
+func DefaultRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   time.Second,
+		MaxDelay:    time.Minute,
+	}
+}
+
+// Retry satisfies the RetryPolicy interface. Rate limited requests are always
+// retried as Freshservice did not act on them; server errors and network
+// failures are only retried for idempotent methods so a ticket is never created twice.
+func (p *BackoffRetryPolicy) Retry(attempt int, req *http.Request, res *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	switch {
+	case res != nil && res.StatusCode == http.StatusTooManyRequests:
+		if d, ok := retryAfter(res); ok {
+			return p.cap(d), true
+		}
+	case err != nil || (res != nil && res.StatusCode >= http.StatusInternalServerError):
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	return p.backoff(attempt), true
+}
+
+// backoff returns the exponential delay for an attempt with "equal jitter" applied.
+// A MaxDelay of zero or less leaves the delay uncapped.
+func (p *BackoffRetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	d = p.cap(d)
+	if d <= 0 {
+		return 0
+	}
+
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func (p *BackoffRetryPolicy) cap(d time.Duration) time.Duration {
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
+
+// retryAfter parses the Retry-After header which can either be
+// the number of seconds to wait or an HTTP date
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	return StringInSlice(method, []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodDelete,
+	})
+}
+
+// sleepContext waits for the duration passed in or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,168 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const roleURL = "/api/v2/roles"
+
+// RoleService is an interface for interacting with
+// the agent role endpoints of the Freshservice API
+type RoleService interface {
+	List(context.Context, QueryFilter) ([]RoleDetails, string, error)
+	ListAll(context.Context, *RoleListOptions) *RoleIterator
+	Get(context.Context, int) (*RoleDetails, error)
+	ValidateAgent(context.Context, *AgentDetails) error
+}
+
+// RoleServiceClient facilitates requests with the RoleService methods
+type RoleServiceClient struct {
+	client *Client
+}
+
+// List all Freshservice agent roles
+func (rs *RoleServiceClient) List(ctx context.Context, filter QueryFilter) ([]RoleDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   roleURL,
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &Roles{}
+	resp, err := rs.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// ListAll returns an iterator over every Freshservice agent role matching the
+// options passed in, requesting additional pages as the iterator advances
+func (rs *RoleServiceClient) ListAll(ctx context.Context, opts *RoleListOptions) *RoleIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &RoleIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := rs.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// RoleIterator iterates over a paginated list of Freshservice agent roles
+type RoleIterator struct {
+	pager
+	page []RoleDetails
+}
+
+// Value returns the agent role the iterator currently points at
+func (it *RoleIterator) Value() RoleDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max agent roles.
+// A max of zero or less will collect every remaining agent role.
+func (it *RoleIterator) Collect(ctx context.Context, max int) ([]RoleDetails, error) {
+	var list []RoleDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Get a specific Freshservice agent role
+func (rs *RoleServiceClient) Get(ctx context.Context, id int) (*RoleDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   rs.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", roleURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Role{}
+	if _, err := rs.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// ValidateAgent checks the roles of an agent payload before it is passed to
+// Agents().Create or Agents().Update. Besides the checks of AgentRole.Validate,
+// every role ID must exist and every group of a specified_groups assignment
+// must be an existing agent group. All problems found are reported together.
+func (rs *RoleServiceClient) ValidateAgent(ctx context.Context, ad *AgentDetails) error {
+	if len(ad.Roles) == 0 {
+		return nil
+	}
+
+	var problems []string
+	needGroups := false
+	for _, ar := range ad.Roles {
+		if err := ar.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("role %d: %s", ar.RoleID, err))
+		}
+		if len(ar.Groups) > 0 {
+			needGroups = true
+		}
+	}
+
+	roleIDs := map[int]bool{}
+	roles := rs.ListAll(ctx, &RoleListOptions{PerPage: MaxPerPage})
+	for roles.Next(ctx) {
+		roleIDs[roles.Value().ID] = true
+	}
+	if err := roles.Err(); err != nil {
+		return err
+	}
+
+	groupIDs := map[int]bool{}
+	if needGroups {
+		groups := rs.client.AgentGroups().ListAll(ctx, &AgentGroupListOptions{PerPage: MaxPerPage})
+		for groups.Next(ctx) {
+			groupIDs[groups.Value().ID] = true
+		}
+		if err := groups.Err(); err != nil {
+			return err
+		}
+	}
+
+	for _, ar := range ad.Roles {
+		if !roleIDs[ar.RoleID] {
+			problems = append(problems, fmt.Sprintf("role %d does not exist", ar.RoleID))
+		}
+		for _, g := range ar.Groups {
+			if !groupIDs[g] {
+				problems = append(problems, fmt.Sprintf("role %d: group %d does not exist", ar.RoleID, g))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid agent roles: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package freshservice
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// AgentRoleType is a role granting permissions to the service desk modules
+	AgentRoleType = 1
+	// AdminRoleType is a role granting permissions to the admin settings
+	AdminRoleType = 2
+)
+
+// Roles holds a list of Freshservice agent roles
+type Roles struct {
+	List []RoleDetails `json:"roles"`
+}
+
+// Role holds the details of a specific Freshservice agent role
+type Role struct {
+	Details RoleDetails `json:"role"`
+}
+
+// RoleDetails are the details related to a specific agent role
+type RoleDetails struct {
+	ID          int                  `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Default     bool                 `json:"default"`
+	RoleType    int                  `json:"role_type"`
+	Scopes      map[string]RoleScope `json:"scopes"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+// RoleScope is the permission a role grants on a module such as
+// "ticket", "problem", "change", "release", "asset", "contract" or "solution"
+type RoleScope struct {
+	Permission  string `json:"permission"`
+	AccessLevel string `json:"access_level"`
+}
+
+// RoleListOptions holds the available options that can be
+// passed when requesting a list of Freshservice agent roles
+type RoleListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *RoleListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
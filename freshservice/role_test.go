@@ -0,0 +1,49 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestAgentRoleValidate(t *testing.T) {
+	ar := &freshservice.AgentRole{RoleID: 1, AssignmentScope: "specified_groups", Groups: []int{5}}
+	assert.Nil(t, ar.Validate())
+
+	ar.Groups = nil
+	assert.NotNil(t, ar.Validate())
+
+	ar = &freshservice.AgentRole{RoleID: 1, AssignmentScope: freshservice.ScopeEntireHelpdesk, Groups: []int{5}}
+	assert.NotNil(t, ar.Validate())
+}
+
+func TestRoleValidateAgent(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/roles":
+			fmt.Fprint(w, `{"roles":[{"id":1,"name":"Agent","role_type":1,"scopes":{"ticket":{"permission":"edit","access_level":"all_items"}}}]}`)
+		case "/api/v2/groups":
+			fmt.Fprint(w, `{"groups":[{"id":5,"name":"Network"}]}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	assert.Nil(t, c.Roles().ValidateAgent(ctx, &freshservice.AgentDetails{
+		Roles: []freshservice.AgentRole{{RoleID: 1, AssignmentScope: freshservice.ScopeSpecifiedGroups, Groups: []int{5}}},
+	}))
+
+	err := c.Roles().ValidateAgent(ctx, &freshservice.AgentDetails{
+		Roles: []freshservice.AgentRole{
+			{RoleID: 1, AssignmentScope: freshservice.ScopeSpecifiedGroups, Groups: []int{6}},
+			{RoleID: 2, AssignmentScope: freshservice.ScopeMemberGroups},
+		},
+	})
+	assert.EqualError(t, err, "invalid agent roles: role 1: group 6 does not exist; role 2 does not exist")
+}
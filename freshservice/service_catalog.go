@@ -1,7 +1,9 @@
 package freshservice
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -18,6 +20,13 @@ type ServiceCatalogService interface {
 	List(context.Context, QueryFilter) ([]ServiceCatalogItemDetails, error)
 	Categories(context.Context) ([]ServiceCategory, error)
 	Get(context.Context, int) (*ServiceCatalogItemDetails, error)
+	Create(context.Context, *ServiceCatalogItemDetails) (*ServiceCatalogItemDetails, error)
+	Update(context.Context, int, *ServiceCatalogItemDetails) (*ServiceCatalogItemDetails, error)
+	Delete(context.Context, int) error
+	CreateCategory(context.Context, *ServiceCategory) (*ServiceCategory, error)
+	UpdateCategory(context.Context, int, *ServiceCategory) (*ServiceCategory, error)
+	DeleteCategory(context.Context, int) error
+	PlaceRequest(context.Context, int, *ServiceRequestDetails) (*PlacedServiceRequest, error)
 }
 
 // ServiceCatalogServiceClient facilitates requests with the ServiceCatalogService methods
@@ -92,3 +101,188 @@ func (sc *ServiceCatalogServiceClient) Get(ctx context.Context, id int) (*Servic
 
 	return &res.Details, nil
 }
+
+// Create a new service catalog item. Items are created as drafts unless
+// their Visibility is set to published.
+func (sc *ServiceCatalogServiceClient) Create(ctx context.Context, sd *ServiceCatalogItemDetails) (*ServiceCatalogItemDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   sc.client.Domain,
+		Path:   serviceCatalogItemURL,
+	}
+
+	serviceItemContent, err := json.Marshal(sd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(serviceItemContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ServiceCatalogItem{}
+	if _, err := sc.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a service catalog item by its display ID. The custom fields
+// sent replace the existing custom fields of the item.
+func (sc *ServiceCatalogServiceClient) Update(ctx context.Context, displayID int, sd *ServiceCatalogItemDetails) (*ServiceCatalogItemDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   sc.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", serviceCatalogItemURL, displayID),
+	}
+
+	serviceItemContent, err := json.Marshal(sd)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(serviceItemContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ServiceCatalogItem{}
+	if _, err := sc.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a service catalog item by its display ID
+func (sc *ServiceCatalogServiceClient) Delete(ctx context.Context, displayID int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   sc.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", serviceCatalogItemURL, displayID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := sc.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateCategory creates a new service catalog category
+func (sc *ServiceCatalogServiceClient) CreateCategory(ctx context.Context, cat *ServiceCategory) (*ServiceCategory, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   sc.client.Domain,
+		Path:   serviceCatalogCategoryURL,
+	}
+
+	categoryContent, err := json.Marshal(cat)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(categoryContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &serviceCategory{}
+	if _, err := sc.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// UpdateCategory updates a service catalog category
+func (sc *ServiceCatalogServiceClient) UpdateCategory(ctx context.Context, id int, cat *ServiceCategory) (*ServiceCategory, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   sc.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", serviceCatalogCategoryURL, id),
+	}
+
+	categoryContent, err := json.Marshal(cat)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(categoryContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &serviceCategory{}
+	if _, err := sc.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// DeleteCategory deletes a service catalog category
+// Note: Categories containing service items can not be deleted.
+func (sc *ServiceCatalogServiceClient) DeleteCategory(ctx context.Context, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   sc.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", serviceCatalogCategoryURL, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := sc.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PlaceRequest orders a service catalog item by its display ID, creating a service
+// request ticket. Requests placed this way go through the approvals and workflows
+// configured for the item.
+func (sc *ServiceCatalogServiceClient) PlaceRequest(ctx context.Context, displayID int, sr *ServiceRequestDetails) (*PlacedServiceRequest, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   sc.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/place_request", serviceCatalogItemURL, displayID),
+	}
+
+	serviceRequestContent, err := json.Marshal(sr)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(serviceRequestContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ServiceRequest{}
+	if _, err := sc.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
@@ -1,6 +1,7 @@
 package freshservice
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -15,36 +16,102 @@ type ServiceCatalogItem struct {
 	Details ServiceCatalogItemDetails `json:"service_item"`
 }
 
-// ServiceCatalogItemDetails holds the details for a specific Freshservice service catalog item
+// ServiceCatalogItemDetails holds the details for a specific Freshservice service catalog item.
+// The boolean settings are pointers so that an update only changes those that are set, e.g. with Bool(false).
 type ServiceCatalogItemDetails struct {
-	ID                     int               `json:"id"`
-	CreatedAt              time.Time         `json:"created_at"`
-	UpdatedAt              time.Time         `json:"updated_at"`
-	Name                   string            `json:"name"`
-	DeliveryTime           int               `json:"delivery_time"`
-	DisplayID              int               `json:"display_id"`
-	CategoryID             int               `json:"category_id"`
-	ProductID              int               `json:"product_id"`
-	Quantity               int               `json:"quantity"`
-	Deleted                bool              `json:"deleted"`
-	IconName               string            `json:"icon_name"`
-	GroupVisibility        int               `json:"group_visibility"`
-	ItemType               int               `json:"item_type"`
-	CiTypeID               int               `json:"ci_type_id"`
-	CostVisibility         bool              `json:"cost_visibility"`
-	DeliveryTimeVisibility bool              `json:"delivery_time_visibility"`
-	Configs                map[string]string `json:"configs"`
-	Botified               bool              `json:"botified"`
-	Visibility             int               `json:"visibility"`
-	AllowAttachments       bool              `json:"allow_attachments"`
-	AllowQuantity          bool              `json:"allow_quantity"`
-	IsBundle               bool              `json:"is_bundle"`
-	CreateChild            bool              `json:"create_child"`
-	Description            string            `json:"description"`
-	ShortDescription       string            `json:"short_description"`
-	Cost                   string            `json:"cost"`
-	CustomFields           []interface{}     `json:"custom_fields"`
-	ChildItems             []interface{}     `json:"child_items"`
+	ID                     int                      `json:"id,omitempty"` // Read-Only
+	CreatedAt              time.Time                `json:"created_at"`   // Read-Only
+	UpdatedAt              time.Time                `json:"updated_at"`   // Read-Only
+	Name                   string                   `json:"name,omitempty"`
+	DeliveryTime           int                      `json:"delivery_time,omitempty"`
+	DisplayID              int                      `json:"display_id,omitempty"` // Read-Only
+	CategoryID             int                      `json:"category_id,omitempty"`
+	ProductID              int                      `json:"product_id,omitempty"`
+	Quantity               int                      `json:"quantity,omitempty"`
+	Deleted                bool                     `json:"deleted"` // Read-Only
+	IconName               string                   `json:"icon_name,omitempty"`
+	GroupVisibility        int                      `json:"group_visibility,omitempty"`
+	ItemType               int                      `json:"item_type,omitempty"`
+	CiTypeID               int                      `json:"ci_type_id,omitempty"`
+	CostVisibility         *bool                    `json:"cost_visibility,omitempty"`
+	DeliveryTimeVisibility *bool                    `json:"delivery_time_visibility,omitempty"`
+	Configs                map[string]string        `json:"configs,omitempty"`
+	Botified               bool                     `json:"botified"` // Read-Only
+	Visibility             int                      `json:"visibility,omitempty"`
+	AllowAttachments       *bool                    `json:"allow_attachments,omitempty"`
+	AllowQuantity          *bool                    `json:"allow_quantity,omitempty"`
+	IsBundle               *bool                    `json:"is_bundle,omitempty"`
+	CreateChild            *bool                    `json:"create_child,omitempty"`
+	Description            string                   `json:"description,omitempty"`
+	ShortDescription       string                   `json:"short_description,omitempty"`
+	Cost                   string                   `json:"cost,omitempty"`
+	CustomFields           []ServiceItemCustomField `json:"custom_fields,omitempty"`
+	ChildItems             []ServiceItemChildItem   `json:"child_items,omitempty"`
+}
+
+// MarshalJSON leaves the read-only fields out of a service catalog item payload
+func (sd ServiceCatalogItemDetails) MarshalJSON() ([]byte, error) {
+	type details ServiceCatalogItemDetails
+	return json.Marshal(struct {
+		details
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		UpdatedAt *time.Time `json:"updated_at,omitempty"`
+		Deleted   *bool      `json:"deleted,omitempty"`
+		Botified  *bool      `json:"botified,omitempty"`
+	}{details: details(sd)})
+}
+
+// ServiceItemChildItem is an item requested along with a bundle
+type ServiceItemChildItem struct {
+	DisplayID int    `json:"display_id"`
+	Name      string `json:"name,omitempty"` // Read-Only
+	Quantity  int    `json:"quantity,omitempty"`
+	Mandatory bool   `json:"mandatory"`
+}
+
+// ServiceItemCustomField is the definition of a field shown to requesters when
+// ordering a service catalog item. The values picked are sent as the CustomFields
+// of a ServiceRequestDetails by Name. Dropdowns whose options depend on the choice
+// made in the dropdown above list those as the NestedFields, with the allowed values
+// found in the NestedOptions of each choice. Fields only displayed once a specific
+// choice has been made are grouped in the Sections of the dropdown.
+type ServiceItemCustomField struct {
+	ID           int                      `json:"id,omitempty"` // Read-Only
+	Name         string                   `json:"name,omitempty"`
+	Label        string                   `json:"label"`
+	FieldType    string                   `json:"field_type"`
+	Description  string                   `json:"description,omitempty"`
+	Position     int                      `json:"position,omitempty"`
+	Required     bool                     `json:"required"`
+	DefaultValue string                   `json:"default_value,omitempty"`
+	Choices      []ServiceItemFieldChoice `json:"choices,omitempty"`
+	NestedFields []ServiceItemNestedField `json:"nested_fields,omitempty"`
+	Sections     []ServiceItemSection     `json:"sections,omitempty"`
+}
+
+// ServiceItemFieldChoice is one of the values allowed by a dropdown field
+type ServiceItemFieldChoice struct {
+	ID            int                      `json:"id,omitempty"` // Read-Only
+	Value         string                   `json:"value"`
+	Position      int                      `json:"position,omitempty"`
+	NestedOptions []ServiceItemFieldChoice `json:"nested_options,omitempty"`
+}
+
+// ServiceItemNestedField is a dropdown depending on the choice made in its parent field
+type ServiceItemNestedField struct {
+	ID    int    `json:"id,omitempty"` // Read-Only
+	Name  string `json:"name,omitempty"`
+	Label string `json:"label"`
+	Level int    `json:"level"`
+}
+
+// ServiceItemSection groups the fields which are only visible once one of
+// the choices, referenced by their value, is picked in the parent dropdown
+type ServiceItemSection struct {
+	ID      int                      `json:"id,omitempty"` // Read-Only
+	Name    string                   `json:"name"`
+	Choices []string                 `json:"choices"`
+	Fields  []ServiceItemCustomField `json:"fields"`
 }
 
 // ServiceCategories represents service catalog item categories in Freshservice
@@ -54,12 +121,27 @@ type ServiceCategories struct {
 
 // ServiceCategory represents a category assigned to a service catalog item in Freshservice
 type ServiceCategory struct {
-	Description string    `json:"description"`
-	ID          int       `json:"id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	Name        string    `json:"name"`
-	Position    int       `json:"position"`
+	Description string    `json:"description,omitempty"`
+	ID          int       `json:"id,omitempty"` // Read-Only
+	CreatedAt   time.Time `json:"created_at"`   // Read-Only
+	UpdatedAt   time.Time `json:"updated_at"`   // Read-Only
+	Name        string    `json:"name,omitempty"`
+	Position    int       `json:"position,omitempty"`
+}
+
+// MarshalJSON leaves the read-only timestamps out of a service category payload
+func (sc ServiceCategory) MarshalJSON() ([]byte, error) {
+	type category ServiceCategory
+	return json.Marshal(struct {
+		category
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	}{category: category(sc)})
+}
+
+// serviceCategory holds a specific Freshservice service catalog category
+type serviceCategory struct {
+	Details ServiceCategory `json:"service_category"`
 }
 
 // ServiceCatalogItemListFilter are the available filter options
@@ -72,3 +154,33 @@ type ServiceCatalogItemListFilter struct {
 func (scf *ServiceCatalogItemListFilter) QueryString() string {
 	return fmt.Sprintf("category_id=%d", scf.CatalogID)
 }
+
+// ServiceRequestDetails is the payload to place a request for a service catalog item.
+// The request is raised for the requester with the Email passed in, or the owner of
+// the API key when empty, on behalf of the RequestedFor email when set. The custom
+// fields are those of the service item being requested.
+type ServiceRequestDetails struct {
+	Quantity     int                       `json:"quantity,omitempty"`
+	RequestedFor string                    `json:"requested_for,omitempty"`
+	Email        string                    `json:"email,omitempty"`
+	CustomFields CustomFields              `json:"custom_fields,omitempty"`
+	ChildItems   []ServiceRequestChildItem `json:"child_items,omitempty"`
+}
+
+// ServiceRequestChildItem is a child item of a bundle to request along with it
+type ServiceRequestChildItem struct {
+	DisplayID int `json:"display_id"`
+	Quantity  int `json:"quantity,omitempty"`
+}
+
+// ServiceRequest holds the service request ticket created by placing a request
+type ServiceRequest struct {
+	Details PlacedServiceRequest `json:"service_request"`
+}
+
+// PlacedServiceRequest is the service request ticket created by placing a
+// request together with the items requested
+type PlacedServiceRequest struct {
+	TicketDetails
+	RequestedItems []RequestedItemDetails `json:"requested_items"`
+}
@@ -0,0 +1,95 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestServiceCatalogPlaceRequest(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/service_catalog/items/14/place_request", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"quantity": 1,
+			"requested_for": "ada@example.com",
+			"custom_fields": {"access_level": "read"},
+			"child_items": [{"display_id": 15, "quantity": 2}]
+		}`, string(body))
+		fmt.Fprint(w, `{"service_request":{"id":301,"subject":"Request for: VPN access","status":2,
+			"requested_items":[{"id":1,"service_item_id":14,"quantity":1,"stage":1}]}}`)
+	})
+	defer srv.Close()
+
+	sr, err := c.ServiceCatalog().PlaceRequest(context.Background(), 14, &freshservice.ServiceRequestDetails{
+		Quantity:     1,
+		RequestedFor: "ada@example.com",
+		CustomFields: freshservice.CustomFields{"access_level": "read"},
+		ChildItems:   []freshservice.ServiceRequestChildItem{{DisplayID: 15, Quantity: 2}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 301, sr.ID)
+	assert.Equal(t, freshservice.TicketOpen, sr.Status)
+	assert.Len(t, sr.RequestedItems, 1)
+	assert.Equal(t, 14, sr.RequestedItems[0].ServiceItemID)
+}
+
+func TestServiceCatalogItemAuthoring(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v2/service_catalog/items/14", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"name": "Laptop",
+			"category_id": 2,
+			"cost_visibility": true,
+			"allow_quantity": false,
+			"custom_fields": [{
+				"name": "os",
+				"label": "Operating system",
+				"field_type": "custom_dropdown",
+				"required": true,
+				"choices": [{"value": "macOS"}, {"value": "Windows"}],
+				"sections": [{"name": "Windows options", "choices": ["Windows"], "fields": [
+					{"label": "Office licence", "field_type": "custom_checkbox", "required": false}
+				]}]
+			}]
+		}`, string(body))
+		fmt.Fprint(w, `{"service_item":{"id":40,"display_id":14,"name":"Laptop","deleted":false,"custom_fields":[
+			{"id":1,"name":"os","label":"Operating system","field_type":"custom_dropdown","required":true,
+			 "choices":[{"id":5,"value":"macOS"},{"id":6,"value":"Windows"}],
+			 "sections":[{"id":9,"name":"Windows options","choices":["Windows"],"fields":[
+				{"id":2,"name":"office_licence","label":"Office licence","field_type":"custom_checkbox","required":false}]}]}
+		]}}`)
+	})
+	defer srv.Close()
+
+	item, err := c.ServiceCatalog().Update(context.Background(), 14, &freshservice.ServiceCatalogItemDetails{
+		Name:           "Laptop",
+		CategoryID:     2,
+		CostVisibility: freshservice.Bool(true),
+		AllowQuantity:  freshservice.Bool(false),
+		CustomFields: []freshservice.ServiceItemCustomField{{
+			Name:      "os",
+			Label:     "Operating system",
+			FieldType: "custom_dropdown",
+			Required:  true,
+			Choices:   []freshservice.ServiceItemFieldChoice{{Value: "macOS"}, {Value: "Windows"}},
+			Sections: []freshservice.ServiceItemSection{{
+				Name:    "Windows options",
+				Choices: []string{"Windows"},
+				Fields:  []freshservice.ServiceItemCustomField{{Label: "Office licence", FieldType: "custom_checkbox"}},
+			}},
+		}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 14, item.DisplayID)
+	assert.Equal(t, 6, item.CustomFields[0].Choices[1].ID)
+	assert.Equal(t, "office_licence", item.CustomFields[0].Sections[0].Fields[0].Name)
+}
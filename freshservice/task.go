@@ -11,6 +11,8 @@ import (
 
 /*
 NOTE: The tasks methods are related to the ticket methods and use the ticket endpoint
+unless accessed through a service such as Changes().Tasks(), in which case the ID
+passed in is that of the parent change rather than a ticket
 */
 
 // TaskService is an interface for interacting with
@@ -18,14 +20,24 @@ NOTE: The tasks methods are related to the ticket methods and use the ticket end
 type TaskService interface {
 	List(context.Context, int) ([]TaskDetails, error)
 	Create(context.Context, int, *TaskDetails) (*TaskDetails, error)
+	CreateWithAttachment(context.Context, int, *TaskDetails, ...FileAttachment) (*TaskDetails, error)
 	Get(context.Context, int, int) (*TaskDetails, error)
 	Update(context.Context, int, int, *TaskDetails) (*TaskDetails, error)
 	Delete(context.Context, int, int) error
 }
 
-// TaskServiceClient facilitates requests with the TicketService methods
+// TaskServiceClient facilitates requests with the TaskService methods
 type TaskServiceClient struct {
 	client *Client
+	// parentURL is the endpoint the tasks are nested under, tickets when empty
+	parentURL string
+}
+
+func (c *TaskServiceClient) parent() string {
+	if c.parentURL == "" {
+		return ticketURL
+	}
+	return c.parentURL
 }
 
 // List all tasks assigned to a given ticket ID
@@ -33,7 +45,7 @@ func (c *TaskServiceClient) List(ctx context.Context, tickID int) ([]TaskDetails
 	url := &url.URL{
 		Scheme: "https",
 		Host:   c.client.Domain,
-		Path:   fmt.Sprintf("%s/%d/tasks", ticketURL, tickID),
+		Path:   fmt.Sprintf("%s/%d/tasks", c.parent(), tickID),
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
@@ -55,7 +67,7 @@ func (c *TaskServiceClient) Get(ctx context.Context, tickID int, tid int) (*Task
 	url := &url.URL{
 		Scheme: "https",
 		Host:   c.client.Domain,
-		Path:   fmt.Sprintf("%s/%d/tasks/%d", ticketURL, tickID, tid),
+		Path:   fmt.Sprintf("%s/%d/tasks/%d", c.parent(), tickID, tid),
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
@@ -76,7 +88,7 @@ func (c *TaskServiceClient) Create(ctx context.Context, tickID int, td *TaskDeta
 	url := &url.URL{
 		Scheme: "https",
 		Host:   c.client.Domain,
-		Path:   fmt.Sprintf("%s/%d/tasks", ticketURL, tickID),
+		Path:   fmt.Sprintf("%s/%d/tasks", c.parent(), tickID),
 	}
 
 	taskContent, err := json.Marshal(td)
@@ -99,12 +111,33 @@ func (c *TaskServiceClient) Create(ctx context.Context, tickID int, td *TaskDeta
 	return &res.Details, nil
 }
 
+// CreateWithAttachment creates a task with attachments on a given ticket by ID
+func (c *TaskServiceClient) CreateWithAttachment(ctx context.Context, tickID int, td *TaskDetails, files ...FileAttachment) (*TaskDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/tasks", c.parent(), tickID),
+	}
+
+	req, err := newMultipartRequest(ctx, http.MethodPost, url.String(), td, files)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Task{}
+	_, err = c.client.makeRequest(req, res)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Details, nil
+}
+
 // Update a specific task for a given ticket ID
 func (c *TaskServiceClient) Update(ctx context.Context, tickID int, tid int, td *TaskDetails) (*TaskDetails, error) {
 	url := &url.URL{
 		Scheme: "https",
 		Host:   c.client.Domain,
-		Path:   fmt.Sprintf("%s/%d/tasks/%d", ticketURL, tickID, tid),
+		Path:   fmt.Sprintf("%s/%d/tasks/%d", c.parent(), tickID, tid),
 	}
 
 	taskContent, err := json.Marshal(td)
@@ -133,7 +166,7 @@ func (c *TaskServiceClient) Delete(ctx context.Context, tickID int, tid int) err
 	url := &url.URL{
 		Scheme: "https",
 		Host:   c.client.Domain,
-		Path:   fmt.Sprintf("%s/%d/tasks/%d", ticketURL, tickID, tid),
+		Path:   fmt.Sprintf("%s/%d/tasks/%d", c.parent(), tickID, tid),
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
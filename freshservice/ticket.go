@@ -9,17 +9,28 @@ import (
 	"net/url"
 )
 
-const ticketURL = "/api/v2/tickets"
+const (
+	ticketURL       = "/api/v2/tickets"
+	ticketFilterURL = "/api/v2/tickets/filter"
+	// The filter endpoint returns 30 tickets per page for at most 10 pages
+	ticketFilterPageSize = 30
+	ticketFilterMaxPages = 10
+)
 
 // TicketService is an interface for interacting with
 // the ticket endpoints of the Freshservice API
 type TicketService interface {
 	List(context.Context, QueryFilter) ([]TicketDetails, string, error)
+	ListAll(context.Context, *TicketListOptions) *TicketIterator
+	Filter(context.Context, *Query) *TicketIterator
 	Create(context.Context, *TicketDetails) (*TicketDetails, error)
-	CreateWithAttachment() (*Ticket, error)
+	CreateWithAttachment(context.Context, *TicketDetails, ...FileAttachment) (*TicketDetails, error)
 	Get(context.Context, int, QueryFilter) (*TicketDetails, error)
 	Update(context.Context, int, *TicketDetails) (*TicketDetails, error)
+	UpdateWithAttachment(context.Context, int, *TicketDetails, ...FileAttachment) (*TicketDetails, error)
 	Delete(context.Context, int) error
+	RequestedItems(context.Context, int) ([]RequestedItemDetails, error)
+	UpdateRequestedItem(context.Context, int, int, *RequestedItemDetails) (*RequestedItemDetails, error)
 }
 
 // TicketServiceClient facilitates requests with the TicketService methods
@@ -55,6 +66,83 @@ func (t *TicketServiceClient) List(ctx context.Context, filter QueryFilter) ([]T
 	return res.List, HasNextPage(resp), nil
 }
 
+// ListAll returns an iterator over every Freshservice ticket matching the
+// options passed in, requesting additional pages as the iterator advances
+func (t *TicketServiceClient) ListAll(ctx context.Context, opts *TicketListOptions) *TicketIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &TicketIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := t.List(ctx, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// TicketIterator iterates over a paginated list of Freshservice tickets
+type TicketIterator struct {
+	pager
+	page []TicketDetails
+}
+
+// Value returns the ticket the iterator currently points at
+func (it *TicketIterator) Value() TicketDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max tickets.
+// A max of zero or less will collect every remaining ticket.
+func (it *TicketIterator) Collect(ctx context.Context, max int) ([]TicketDetails, error) {
+	var list []TicketDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// Filter returns an iterator over the tickets matching a query, e.g.
+// Q().Eq("priority", UrgentPriority).Eq("status", TicketOpen). Freshservice
+// returns at most 10 pages of 30 tickets for a single query.
+func (t *TicketServiceClient) Filter(ctx context.Context, q *Query) *TicketIterator {
+	iter := &TicketIterator{}
+	page := 0
+	iter.pager = newPager(ctx, nil, func(ctx context.Context, _ QueryFilter) (int, string, error) {
+		if err := q.Validate(); err != nil {
+			return 0, "", err
+		}
+		page++
+
+		url := &url.URL{
+			Scheme:   "https",
+			Host:     t.client.Domain,
+			Path:     ticketFilterURL,
+			RawQuery: fmt.Sprintf("%s&page=%d", q.QueryString(), page),
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+		if err != nil {
+			return 0, "", err
+		}
+
+		res := &Tickets{}
+		if _, err := t.client.makeRequest(req, res); err != nil {
+			return 0, "", err
+		}
+		iter.page = res.List
+
+		var next string
+		if len(res.List) == ticketFilterPageSize && page < ticketFilterMaxPages {
+			next = fmt.Sprintf("page=%d", page+1)
+		}
+		return len(res.List), next, nil
+	})
+	return iter
+}
+
 // Create a new Freshservice ticket
 func (t *TicketServiceClient) Create(ctx context.Context, td *TicketDetails) (*TicketDetails, error) {
 	url := &url.URL{
@@ -83,9 +171,27 @@ func (t *TicketServiceClient) Create(ctx context.Context, td *TicketDetails) (*T
 	return &res.Details, nil
 }
 
-// CreateWithAttachment creates new Freshservice ticket with attachment
-func (t *TicketServiceClient) CreateWithAttachment() (*Ticket, error) {
-	return nil, nil
+// CreateWithAttachment creates new Freshservice ticket with attachments.
+// The ticket is sent as multipart form data and the files are streamed
+// rather than read into memory. The total size of the files can not exceed 15 MB.
+func (t *TicketServiceClient) CreateWithAttachment(ctx context.Context, td *TicketDetails, files ...FileAttachment) (*TicketDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   t.client.Domain,
+		Path:   ticketURL,
+	}
+
+	req, err := newMultipartRequest(ctx, http.MethodPost, url.String(), td, files)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Ticket{}
+	if _, err := t.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
 }
 
 // Get a specific Freshservice ticket by Ticket ID. By default, certain
@@ -141,6 +247,26 @@ func (t *TicketServiceClient) Update(ctx context.Context, id int, details *Ticke
 	return &res.Details, nil
 }
 
+// UpdateWithAttachment updates a Freshservice ticket adding the attachments passed in
+func (t *TicketServiceClient) UpdateWithAttachment(ctx context.Context, id int, details *TicketDetails, files ...FileAttachment) (*TicketDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   t.client.Domain,
+		Path:   fmt.Sprintf("%s/%d", ticketURL, id),
+	}
+
+	req, err := newMultipartRequest(ctx, http.MethodPut, url.String(), details, files)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Ticket{}
+	if _, err := t.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+	return &res.Details, nil
+}
+
 // Delete Freshservice ticket
 func (t *TicketServiceClient) Delete(ctx context.Context, id int) error {
 	url := &url.URL{
@@ -160,3 +286,54 @@ func (t *TicketServiceClient) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// RequestedItems lists the service catalog items requested on a service request
+// ticket, including the options picked by the requester as their custom fields
+func (t *TicketServiceClient) RequestedItems(ctx context.Context, tickID int) ([]RequestedItemDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   t.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/requested_items", ticketURL, tickID),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RequestedItems{}
+	if _, err := t.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
+
+// UpdateRequestedItem updates an item requested on a service request
+// ticket, e.g. setting its Stage to RequestedItemDelivered once fulfilled
+func (t *TicketServiceClient) UpdateRequestedItem(ctx context.Context, tickID int, itemID int, item *RequestedItemDetails) (*RequestedItemDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   t.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/requested_items/%d", ticketURL, tickID, itemID),
+	}
+
+	requestedItemContent, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(requestedItemContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RequestedItem{}
+	if _, err := t.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
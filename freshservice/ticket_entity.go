@@ -2,6 +2,7 @@ package freshservice
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 )
@@ -92,6 +93,50 @@ type TicketDetails struct {
 	Attachments     []Attachment `json:"attachments"`
 }
 
+// ValidateAgainst checks a ticket payload against the ticket form schema returned
+// by TicketFields().List before it is passed to Tickets().Create. Dropdown values,
+// including dependent fields such as sub_category, must be one of the choices of
+// the schema, fields required for agents must be set, as must fields required for
+// closure when the ticket is resolved or closed, and every custom field must exist.
+// All problems found are reported together.
+func (td *TicketDetails) ValidateAgainst(schema TicketFormSchema) error {
+	return td.validateAgainst(schema, false)
+}
+
+// ValidateAgainstForUpdate checks a ticket payload before it is passed to
+// Tickets().Update like ValidateAgainst, except that required fields may be
+// left out since an update only changes the fields that are set.
+func (td *TicketDetails) ValidateAgainstForUpdate(schema TicketFormSchema) error {
+	return td.validateAgainst(schema, true)
+}
+
+func (td *TicketDetails) validateAgainst(schema TicketFormSchema, partial bool) error {
+	var problems []string
+	nested := map[string]bool{}
+	for _, f := range schema {
+		for _, nf := range f.NestedFields {
+			nested[nf.Name] = true
+		}
+	}
+
+	for i := range schema {
+		problems = td.validateTicketField(&schema[i], partial, problems)
+	}
+
+	for name := range td.CustomFields {
+		if _, ok := schema.Field(name); !ok && !nested[name] {
+			problems = append(problems, fmt.Sprintf("%s is not a ticket field", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid ticket: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
 // CarbonCopy manages the emails to be copied in on a ticket
 type CarbonCopy struct {
 	CcEmails  []string `json:"cc_emails"`
@@ -107,22 +152,24 @@ type TicketNote struct {
 
 // TicketNoteDetails holds the details of a note added to a Freshservice ticket
 type TicketNoteDetails struct {
-	ID           int64        `json:"id"` // Read-Only
-	UserID       int64        `json:"user_id"`
-	Source       int          `json:"source"`
-	Incoming     bool         `json:"incoming"`
+	ID           int64        `json:"id,omitempty"` // Read-Only
+	UserID       int64        `json:"user_id,omitempty"`
+	Source       int          `json:"source,omitempty"`
+	Incoming     bool         `json:"incoming,omitempty"`
 	Private      bool         `json:"private"`
-	CreatedAt    string       `json:"created_at"`
-	UpdatedAt    string       `json:"updated_at"`
-	Deleted      bool         `json:"deleted"`
-	Body         string       `json:"body"`
-	BodyHTML     string       `json:"body_html"`   // Mandatory
-	Attachments  []Attachment `json:"attachments"` // Read-Only
-	SupportEmail interface{}  `json:"support_email"`
+	NotifyEmails []string     `json:"notify_emails,omitempty"`
+	CreatedAt    string       `json:"created_at,omitempty"`
+	UpdatedAt    string       `json:"updated_at,omitempty"`
+	Deleted      bool         `json:"deleted,omitempty"`
+	Body         string       `json:"body,omitempty"`
+	BodyHTML     string       `json:"body_html,omitempty"`   // Mandatory
+	Attachments  []Attachment `json:"attachments,omitempty"` // Read-Only
+	SupportEmail interface{}  `json:"support_email,omitempty"`
 }
 
 // Attachment represents a ticket attachment
 type Attachment struct {
+	ID            int       `json:"id"`
 	ContentType   string    `json:"content_type"`
 	Size          int       `json:"size"`
 	Name          string    `json:"name"`
@@ -131,13 +178,15 @@ type Attachment struct {
 	UpdatedAt     time.Time `json:"updated_at"`
 }
 
-// CustomFields holds a mapping of custom ticket fields
+// CustomFields holds a mapping of custom field names to their values for
+// tickets, agents, requesters and the other entities supporting custom fields
 type CustomFields map[string]interface{}
 
 // TicketListOptions holds the available options that can be
 // passed when requesting a list of Freshservice ticketsx
 type TicketListOptions struct {
 	PageQuery string
+	PerPage   int
 	FilterBy  *TicketFilter
 	SortBy    *SortOptions
 	Embed     *TicketEmbedOptions
@@ -178,6 +227,10 @@ func (opts *TicketListOptions) QueryString() string {
 		qs = append(qs, opts.PageQuery)
 	}
 
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
 	if opts.FilterBy != nil {
 		switch {
 		case opts.FilterBy.NewAndMyOpen:
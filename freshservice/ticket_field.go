@@ -0,0 +1,42 @@
+package freshservice
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const ticketFieldURL = "/api/v2/ticket_form_fields"
+
+// TicketFieldService is an interface for interacting with
+// the ticket form field endpoints of the Freshservice API
+type TicketFieldService interface {
+	List(context.Context) (TicketFormSchema, error)
+}
+
+// TicketFieldServiceClient facilitates requests with the TicketFieldService methods
+type TicketFieldServiceClient struct {
+	client *Client
+}
+
+// List returns the ticket form schema with every default and custom
+// field, their choices and dependent nested fields
+func (tf *TicketFieldServiceClient) List(ctx context.Context) (TicketFormSchema, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   tf.client.Domain,
+		Path:   ticketFieldURL,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TicketFields{}
+	if _, err := tf.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return res.List, nil
+}
@@ -0,0 +1,177 @@
+package freshservice
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// TicketFields holds the ticket form fields returned by the Freshservice API
+type TicketFields struct {
+	List TicketFormSchema `json:"ticket_fields"`
+}
+
+// TicketFormSchema is the full list of default and custom ticket form fields,
+// see TicketDetails.ValidateAgainst
+type TicketFormSchema []TicketFieldDetails
+
+// Field returns the field with the name passed in
+func (s TicketFormSchema) Field(name string) (*TicketFieldDetails, bool) {
+	for i := range s {
+		if s[i].Name == name {
+			return &s[i], true
+		}
+	}
+	return nil, false
+}
+
+// TicketFieldDetails is the definition of a single ticket form field. Custom
+// fields are read and written through the CustomFields of a ticket by Name.
+// Dependent fields such as category, sub_category and item_category are
+// listed as the NestedFields of the top level field, with the allowed values
+// of each level found in the NestedOptions of the choice made at the level above.
+type TicketFieldDetails struct {
+	ID                   int                 `json:"id"`
+	WorkspaceID          int                 `json:"workspace_id"`
+	Name                 string              `json:"name"`
+	Label                string              `json:"label"`
+	Description          string              `json:"description"`
+	FieldType            string              `json:"field_type"`
+	Position             int                 `json:"position"`
+	DefaultField         bool                `json:"default_field"`
+	RequiredForAgents    bool                `json:"required_for_agents"`
+	RequiredForCustomers bool                `json:"required_for_customers"`
+	RequiredForClosure   bool                `json:"required_for_closure"`
+	DisplayedToCustomers bool                `json:"displayed_to_customers"`
+	Choices              []TicketFieldChoice `json:"choices"`
+	NestedFields         []TicketNestedField `json:"nested_fields"`
+	CreatedAt            time.Time           `json:"created_at"`
+	UpdatedAt            time.Time           `json:"updated_at"`
+}
+
+// TicketFieldChoice is one of the values allowed by a dropdown field.
+// Default fields such as status and priority are set by ID, other
+// dropdowns by Value.
+type TicketFieldChoice struct {
+	ID            int                 `json:"id"`
+	Value         string              `json:"value"`
+	NestedOptions []TicketFieldChoice `json:"nested_options"`
+}
+
+// TicketNestedField is a field depending on the choice made in its parent field
+type TicketNestedField struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Level       int    `json:"level"`
+	Description string `json:"description"`
+}
+
+// ticketFieldValue returns the value of a form field on a ticket, and
+// false for default fields which are not part of TicketDetails
+func (td *TicketDetails) ticketFieldValue(name string, defaultField bool) (interface{}, bool) {
+	if !defaultField {
+		return td.CustomFields[name], true
+	}
+
+	switch name {
+	case "subject":
+		return td.Subject, true
+	case "description":
+		return td.Description, true
+	case "status":
+		return td.Status, true
+	case "priority":
+		return td.Priority, true
+	case "source":
+		return td.Source, true
+	case "urgency":
+		return td.Urgency, true
+	case "impact":
+		return td.Impact, true
+	case "ticket_type":
+		return td.Type, true
+	case "group", "group_id":
+		return td.GroupID, true
+	case "agent", "responder_id":
+		return td.ResponderID, true
+	case "department", "department_id":
+		return td.DepartmentID, true
+	case "category":
+		return td.Category, true
+	case "sub_category":
+		return td.SubCategory, true
+	case "item_category":
+		return td.ItemCategory, true
+	}
+
+	return nil, false
+}
+
+func isEmptyFieldValue(v interface{}) bool {
+	return v == nil || v == "" || v == 0
+}
+
+// findTicketFieldChoice returns the choice matching a value by Value,
+// falling back to the ID of the choice for integer values
+func findTicketFieldChoice(choices []TicketFieldChoice, v interface{}) (*TicketFieldChoice, bool) {
+	i := choiceIndex(len(choices), func(i int) string { return choices[i].Value }, v)
+	if _, ok := v.(int); ok && i < 0 {
+		i = choiceIndex(len(choices), func(i int) string { return strconv.Itoa(choices[i].ID) }, v)
+	}
+
+	if i < 0 {
+		return nil, false
+	}
+	return &choices[i], true
+}
+
+// validateTicketField appends the problems found with the value of a single
+// form field, and its nested fields, to the problems passed in. A partial
+// payload does not need to set the required fields.
+func (td *TicketDetails) validateTicketField(f *TicketFieldDetails, partial bool, problems []string) []string {
+	v, ok := td.ticketFieldValue(f.Name, f.DefaultField)
+	if !ok {
+		return problems
+	}
+
+	closing := td.Status == TicketResolved || td.Status == TicketClosed
+	if isEmptyFieldValue(v) {
+		if !partial && (f.RequiredForAgents || (closing && f.RequiredForClosure)) {
+			problems = append(problems, fmt.Sprintf("%s is required", f.Name))
+		}
+		return problems
+	}
+
+	if len(f.Choices) == 0 {
+		return problems
+	}
+
+	choice, ok := findTicketFieldChoice(f.Choices, v)
+	if !ok {
+		return append(problems, fmt.Sprintf("%s does not allow the value %v", f.Name, v))
+	}
+
+	nested := append([]TicketNestedField{}, f.NestedFields...)
+	sort.Slice(nested, func(i, j int) bool { return nested[i].Level < nested[j].Level })
+
+	parent := f.Name
+	for _, nf := range nested {
+		nv, ok := td.ticketFieldValue(nf.Name, true)
+		if !ok {
+			nv, _ = td.ticketFieldValue(nf.Name, false)
+		}
+
+		if isEmptyFieldValue(nv) {
+			break
+		}
+
+		if choice, ok = findTicketFieldChoice(choice.NestedOptions, nv); !ok {
+			return append(problems, fmt.Sprintf("%s does not allow the value %v for the chosen %s", nf.Name, nv, parent))
+		}
+		parent = nf.Name
+	}
+
+	return problems
+}
@@ -0,0 +1,73 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+const ticketFormFields = `{"ticket_fields":[
+	{"id":1,"name":"subject","field_type":"default_subject","default_field":true,"required_for_agents":true},
+	{"id":2,"name":"status","field_type":"default_status","default_field":true,"required_for_agents":true,
+		"choices":[{"id":2,"value":"Open"},{"id":3,"value":"Pending"},{"id":4,"value":"Resolved"},{"id":5,"value":"Closed"}]},
+	{"id":3,"name":"category","field_type":"default_category","default_field":true,
+		"choices":[{"id":10,"value":"Hardware","nested_options":[
+			{"id":11,"value":"Computer","nested_options":[{"id":12,"value":"Mac"},{"id":13,"value":"Windows"}]}
+		]}],
+		"nested_fields":[{"id":4,"name":"sub_category","level":2},{"id":5,"name":"item_category","level":3}]},
+	{"id":6,"name":"root_cause","field_type":"custom_dropdown","default_field":false,"required_for_closure":true,
+		"choices":[{"id":1,"value":"User error"},{"id":2,"value":"Defect"}]}
+]}`
+
+func TestTicketValidateAgainst(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/ticket_form_fields", r.URL.Path)
+		fmt.Fprint(w, ticketFormFields)
+	})
+	defer srv.Close()
+
+	schema, err := c.TicketFields().List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, schema, 4)
+
+	td := &freshservice.TicketDetails{
+		Subject:      "Laptop will not boot",
+		Status:       freshservice.TicketOpen,
+		Category:     "Hardware",
+		SubCategory:  "Computer",
+		ItemCategory: "Mac",
+	}
+	assert.Nil(t, td.ValidateAgainst(schema))
+
+	td.Status = freshservice.TicketClosed
+	td.ItemCategory = "Linux"
+	td.CustomFields = freshservice.CustomFields{"house": "Gryffindor"}
+	assert.EqualError(t, td.ValidateAgainst(schema),
+		"invalid ticket: house is not a ticket field; item_category does not allow the value Linux for the chosen sub_category; root_cause is required")
+
+	td = &freshservice.TicketDetails{Status: 9, CustomFields: freshservice.CustomFields{"root_cause": "Defect"}}
+	assert.EqualError(t, td.ValidateAgainst(schema),
+		"invalid ticket: status does not allow the value 9; subject is required")
+}
+
+func TestTicketValidateAgainstForUpdate(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, ticketFormFields)
+	})
+	defer srv.Close()
+
+	schema, err := c.TicketFields().List(context.Background())
+	assert.Nil(t, err)
+
+	td := &freshservice.TicketDetails{Priority: freshservice.HighPriority}
+	assert.NotNil(t, td.ValidateAgainst(schema))
+	assert.Nil(t, td.ValidateAgainstForUpdate(schema))
+
+	td = &freshservice.TicketDetails{Status: freshservice.TicketClosed, Category: "Software"}
+	assert.EqualError(t, td.ValidateAgainstForUpdate(schema),
+		"invalid ticket: category does not allow the value Software")
+}
@@ -0,0 +1,236 @@
+package freshservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+/*
+NOTE: Time entries are logged against tickets unless accessed through a service
+such as Changes().TimeEntries(), in which case the ID passed in is that of the parent change
+*/
+
+// TimeEntryService is an interface for interacting with
+// the time entry endpoints of the Freshservice API
+type TimeEntryService interface {
+	List(context.Context, int) ([]TimeEntryDetails, error)
+	ListAll(context.Context, int, *TimeEntryListOptions) *TimeEntryIterator
+	Create(context.Context, int, *TimeEntryDetails) (*TimeEntryDetails, error)
+	Get(context.Context, int, int) (*TimeEntryDetails, error)
+	Update(context.Context, int, int, *TimeEntryDetails) (*TimeEntryDetails, error)
+	Delete(context.Context, int, int) error
+	StartTimer(context.Context, int, int) (*TimeEntryDetails, error)
+	StopTimer(context.Context, int, int) (*TimeEntryDetails, error)
+	Summary(context.Context, int) (*TimeEntrySummary, error)
+}
+
+// TimeEntryServiceClient facilitates requests with the TimeEntryService methods
+type TimeEntryServiceClient struct {
+	client *Client
+	// parentURL is the endpoint the time entries are nested under, tickets when empty
+	parentURL string
+}
+
+func (c *TimeEntryServiceClient) parent() string {
+	if c.parentURL == "" {
+		return ticketURL
+	}
+	return c.parentURL
+}
+
+// List the first page of time entries logged against a given parent ID.
+// Use ListAll to page through every time entry.
+func (c *TimeEntryServiceClient) List(ctx context.Context, parentID int) ([]TimeEntryDetails, error) {
+	list, _, err := c.list(ctx, parentID, nil)
+	return list, err
+}
+
+// ListAll returns an iterator over every time entry logged against a given
+// parent ID, requesting additional pages as the iterator advances
+func (c *TimeEntryServiceClient) ListAll(ctx context.Context, parentID int, opts *TimeEntryListOptions) *TimeEntryIterator {
+	var filter QueryFilter
+	if opts != nil {
+		filter = opts
+	}
+
+	iter := &TimeEntryIterator{}
+	iter.pager = newPager(ctx, filter, func(ctx context.Context, f QueryFilter) (int, string, error) {
+		list, next, err := c.list(ctx, parentID, f)
+		iter.page = list
+		return len(list), next, err
+	})
+	return iter
+}
+
+// TimeEntryIterator iterates over a paginated list of Freshservice time entries
+type TimeEntryIterator struct {
+	pager
+	page []TimeEntryDetails
+}
+
+// Value returns the time entry the iterator currently points at
+func (it *TimeEntryIterator) Value() TimeEntryDetails {
+	return it.page[it.pos]
+}
+
+// Collect drains the iterator returning at most max time entries.
+// A max of zero or less will collect every remaining time entry.
+func (it *TimeEntryIterator) Collect(ctx context.Context, max int) ([]TimeEntryDetails, error) {
+	var list []TimeEntryDetails
+	for (max <= 0 || len(list) < max) && it.Next(ctx) {
+		list = append(list, it.Value())
+	}
+	return list, it.Err()
+}
+
+// list requests a single page of the time entries logged against a given parent ID
+func (c *TimeEntryServiceClient) list(ctx context.Context, parentID int, filter QueryFilter) ([]TimeEntryDetails, string, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/time_entries", c.parent(), parentID),
+	}
+
+	if filter != nil {
+		url.RawQuery = filter.QueryString()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := &TimeEntries{}
+	resp, err := c.client.makeRequest(req, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.List, HasNextPage(resp), nil
+}
+
+// Create a time entry against a given parent ID
+func (c *TimeEntryServiceClient) Create(ctx context.Context, parentID int, te *TimeEntryDetails) (*TimeEntryDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/time_entries", c.parent(), parentID),
+	}
+
+	timeEntryContent, err := json.Marshal(te)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(timeEntryContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TimeEntry{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Get a specific time entry logged against a given parent ID
+func (c *TimeEntryServiceClient) Get(ctx context.Context, parentID int, id int) (*TimeEntryDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/time_entries/%d", c.parent(), parentID, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TimeEntry{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Update a specific time entry logged against a given parent ID
+func (c *TimeEntryServiceClient) Update(ctx context.Context, parentID int, id int, te *TimeEntryDetails) (*TimeEntryDetails, error) {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/time_entries/%d", c.parent(), parentID, id),
+	}
+
+	timeEntryContent, err := json.Marshal(te)
+	if err != nil {
+		return nil, err
+	}
+
+	body := bytes.NewReader(timeEntryContent)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TimeEntry{}
+	if _, err := c.client.makeRequest(req, res); err != nil {
+		return nil, err
+	}
+
+	return &res.Details, nil
+}
+
+// Delete a specific time entry logged against a given parent ID
+func (c *TimeEntryServiceClient) Delete(ctx context.Context, parentID int, id int) error {
+	url := &url.URL{
+		Scheme: "https",
+		Host:   c.client.Domain,
+		Path:   fmt.Sprintf("%s/%d/time_entries/%d", c.parent(), parentID, id),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.makeRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StartTimer starts the timer of a specific time entry logged against a given parent ID.
+// Freshservice adds the time elapsed until the timer is stopped to the TimeSpent.
+func (c *TimeEntryServiceClient) StartTimer(ctx context.Context, parentID int, id int) (*TimeEntryDetails, error) {
+	running := true
+	return c.Update(ctx, parentID, id, &TimeEntryDetails{TimerRunning: &running})
+}
+
+// StopTimer stops the running timer of a specific time entry logged against a given parent ID
+func (c *TimeEntryServiceClient) StopTimer(ctx context.Context, parentID int, id int) (*TimeEntryDetails, error) {
+	running := false
+	return c.Update(ctx, parentID, id, &TimeEntryDetails{TimerRunning: &running})
+}
+
+// Summary lists every time entry logged against a given parent ID and totals the
+// time spent, billable or not and by agent. Time entries can be aggregated per
+// department by joining the totals of tickets on their DepartmentID.
+func (c *TimeEntryServiceClient) Summary(ctx context.Context, parentID int) (*TimeEntrySummary, error) {
+	entries, err := c.ListAll(ctx, parentID, &TimeEntryListOptions{PerPage: MaxPerPage}).Collect(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return summarizeTimeEntries(entries)
+}
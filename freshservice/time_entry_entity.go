@@ -0,0 +1,116 @@
+package freshservice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeEntries holds a list of Freshservice time entries
+type TimeEntries struct {
+	List []TimeEntryDetails `json:"time_entries"`
+}
+
+// TimeEntry holds the details of a specific Freshservice time entry
+type TimeEntry struct {
+	Details TimeEntryDetails `json:"time_entry"`
+}
+
+// TimeEntryDetails are the details of time logged against a Freshservice ticket, change, problem or release
+type TimeEntryDetails struct {
+	ID           int        `json:"id,omitempty"` // Read-Only
+	AgentID      int        `json:"agent_id,omitempty"`
+	TaskID       int        `json:"task_id,omitempty"`
+	Billable     *bool      `json:"billable,omitempty"`
+	TimerRunning *bool      `json:"timer_running,omitempty"`
+	TimeSpent    string     `json:"time_spent,omitempty"` // hh:mm
+	Note         string     `json:"note,omitempty"`
+	StartTime    *time.Time `json:"start_time,omitempty"`
+	ExecutedAt   *time.Time `json:"executed_at,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"` // Read-Only
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"` // Read-Only
+}
+
+// TimeEntryListOptions holds the available options that can be
+// passed when requesting a list of Freshservice time entries
+type TimeEntryListOptions struct {
+	PageQuery string
+	PerPage   int
+}
+
+// QueryString allows the available filter items to meet the QueryFilter interface
+func (opts *TimeEntryListOptions) QueryString() string {
+	var qs []string
+	if opts.PageQuery != "" {
+		qs = append(qs, opts.PageQuery)
+	}
+
+	if opts.PerPage > 0 {
+		qs = append(qs, perPageQuery(opts.PerPage))
+	}
+
+	return strings.Join(qs, "&")
+}
+
+// Duration returns the TimeSpent of a time entry as a duration
+func (te *TimeEntryDetails) Duration() (time.Duration, error) {
+	if te.TimeSpent == "" {
+		return 0, nil
+	}
+
+	parts := strings.Split(te.TimeSpent, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("time spent %q is not formatted as hh:mm", te.TimeSpent)
+	}
+
+	h, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("time spent %q is not formatted as hh:mm", te.TimeSpent)
+	}
+
+	m, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("time spent %q is not formatted as hh:mm", te.TimeSpent)
+	}
+
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// FormatTimeSpent formats a duration as the hh:mm expected in TimeSpent,
+// rounded to the nearest minute
+func FormatTimeSpent(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// TimeEntrySummary holds the time entries logged against a parent along with their totals
+type TimeEntrySummary struct {
+	Entries     []TimeEntryDetails
+	Total       time.Duration
+	Billable    time.Duration
+	NonBillable time.Duration
+	// ByAgent is the total time logged by each agent ID
+	ByAgent map[int]time.Duration
+}
+
+// summarizeTimeEntries totals the time entries passed in. Entries are
+// billable unless Billable is set to false, matching Freshservice.
+func summarizeTimeEntries(entries []TimeEntryDetails) (*TimeEntrySummary, error) {
+	sum := &TimeEntrySummary{Entries: entries, ByAgent: map[int]time.Duration{}}
+	for i := range entries {
+		d, err := entries[i].Duration()
+		if err != nil {
+			return nil, err
+		}
+
+		sum.Total += d
+		sum.ByAgent[entries[i].AgentID] += d
+		if entries[i].Billable != nil && !*entries[i].Billable {
+			sum.NonBillable += d
+		} else {
+			sum.Billable += d
+		}
+	}
+	return sum, nil
+}
@@ -0,0 +1,58 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestTimeEntrySummary(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/tickets/301/time_entries", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<https://%s/api/v2/tickets/301/time_entries?page=2&per_page=100>; rel="next"`, r.Host))
+			fmt.Fprint(w, `{"time_entries":[
+				{"id":1,"agent_id":7,"time_spent":"01:30"},
+				{"id":2,"agent_id":8,"time_spent":"00:45","billable":false}
+			]}`)
+			return
+		}
+		fmt.Fprint(w, `{"time_entries":[{"id":3,"agent_id":7,"time_spent":"02:00","billable":true,"task_id":4}]}`)
+	})
+	defer srv.Close()
+
+	sum, err := c.TimeEntries().Summary(context.Background(), 301)
+	assert.Nil(t, err)
+	assert.Len(t, sum.Entries, 3)
+	assert.Equal(t, 4*time.Hour+15*time.Minute, sum.Total)
+	assert.Equal(t, 3*time.Hour+30*time.Minute, sum.Billable)
+	assert.Equal(t, 45*time.Minute, sum.NonBillable)
+	assert.Equal(t, 3*time.Hour+30*time.Minute, sum.ByAgent[7])
+}
+
+func TestTimeEntryTimer(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v2/tickets/301/time_entries/2", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"timer_running":false}`, string(body))
+		fmt.Fprint(w, `{"time_entry":{"id":2,"timer_running":false,"time_spent":"00:12"}}`)
+	})
+	defer srv.Close()
+
+	te, err := c.TimeEntries().StopTimer(context.Background(), 301, 2)
+	assert.Nil(t, err)
+	assert.False(t, *te.TimerRunning)
+
+	d, err := te.Duration()
+	assert.Nil(t, err)
+	assert.Equal(t, 12*time.Minute, d)
+	assert.Equal(t, "12:05", freshservice.FormatTimeSpent(12*time.Hour+4*time.Minute+40*time.Second))
+}
@@ -0,0 +1,102 @@
+package freshservice
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AgentFields holds the definitions of the Freshservice agent fields
+type AgentFields struct {
+	List []UserFieldDetails `json:"agent_fields"`
+}
+
+// RequesterFields holds the definitions of the Freshservice requester fields
+type RequesterFields struct {
+	List []UserFieldDetails `json:"requester_fields"`
+}
+
+// UserFieldDetails is the definition of an agent or requester field. Default
+// fields are set on AgentDetails and RequesterDetails directly while the others
+// are read and written through their CustomFields by Name. Custom field types are
+// prefixed with custom_, e.g. custom_text, custom_number or custom_dropdown.
+type UserFieldDetails struct {
+	ID                     int               `json:"id"`
+	Name                   string            `json:"name"`
+	Label                  string            `json:"label"`
+	LabelForRequesters     string            `json:"label_for_requesters"`
+	Position               int               `json:"position"`
+	Type                   string            `json:"type"`
+	Default                bool              `json:"default"`
+	EditableInSignup       bool              `json:"editable_in_signup"`
+	MandatoryForAgents     bool              `json:"mandatory_for_agents"`
+	MandatoryForRequesters bool              `json:"mandatory_for_customers"`
+	Choices                []UserFieldChoice `json:"choices"`
+	CreatedAt              time.Time         `json:"created_at"`
+	UpdatedAt              time.Time         `json:"updated_at"`
+}
+
+// UserFieldChoice is one of the values allowed by a dropdown field
+type UserFieldChoice struct {
+	ID    int    `json:"id"`
+	Value string `json:"value"`
+}
+
+// ValidateCustomFields checks the custom fields of an agent or requester payload
+// against the field definitions returned by Agents().Fields or Requesters().Fields.
+// Unknown fields, missing mandatory fields and dropdown values that are not one
+// of the choices are reported.
+func ValidateCustomFields(fields []UserFieldDetails, cf CustomFields) error {
+	return validateCustomFields(fields, cf, false)
+}
+
+// ValidateCustomFieldsForUpdate checks the custom fields of an agent or requester
+// update like ValidateCustomFields, except that mandatory fields may be left out
+// since an update only changes the fields that are sent.
+func ValidateCustomFieldsForUpdate(fields []UserFieldDetails, cf CustomFields) error {
+	return validateCustomFields(fields, cf, true)
+}
+
+func validateCustomFields(fields []UserFieldDetails, cf CustomFields, partial bool) error {
+	known := map[string]UserFieldDetails{}
+	for _, fd := range fields {
+		if !fd.Default {
+			known[fd.Name] = fd
+		}
+	}
+
+	var problems []string
+	for name, fd := range known {
+		v, ok := cf[name]
+		if !fd.MandatoryForAgents || (partial && !ok) {
+			continue
+		}
+		if !ok || v == nil || v == "" {
+			problems = append(problems, fmt.Sprintf("%s is required", name))
+		}
+	}
+
+	for name, v := range cf {
+		fd, ok := known[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is not a custom field", name))
+			continue
+		}
+
+		if len(fd.Choices) > 0 && v != nil && !fd.hasChoice(v) {
+			problems = append(problems, fmt.Sprintf("%s does not allow the value %v", name, v))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid custom fields: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func (fd *UserFieldDetails) hasChoice(v interface{}) bool {
+	return choiceIndex(len(fd.Choices), func(i int) string { return fd.Choices[i].Value }, v) >= 0
+}
@@ -0,0 +1,60 @@
+package freshservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veltorg/go-freshservice/freshservice"
+)
+
+func TestRequesterCustomFields(t *testing.T) {
+	rd := &freshservice.RequesterDetails{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"first_name":"Ada","custom_fields":{"cost_center":"CC-100","employee_id":4021}}`), rd))
+	assert.Equal(t, "CC-100", rd.CustomFields["cost_center"])
+
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/requester_fields", r.URL.Path)
+		fmt.Fprint(w, `{"requester_fields":[
+			{"id":1,"name":"first_name","label":"First Name","type":"default_first_name","default":true,"mandatory_for_agents":true},
+			{"id":2,"name":"cost_center","label":"Cost Center","type":"custom_dropdown","default":false,"mandatory_for_agents":true,"choices":[{"id":1,"value":"CC-100"},{"id":2,"value":"CC-200"}]},
+			{"id":3,"name":"employee_id","label":"Employee ID","type":"custom_number","default":false}
+		]}`)
+	})
+	defer srv.Close()
+
+	fields, err := c.Requesters().Fields(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, fields, 3)
+	assert.Nil(t, freshservice.ValidateCustomFields(fields, rd.CustomFields))
+
+	err = freshservice.ValidateCustomFields(fields, freshservice.CustomFields{"cost_center": "CC-300", "house": "Gryffindor"})
+	assert.EqualError(t, err, "invalid custom fields: cost_center does not allow the value CC-300; house is not a custom field")
+}
+
+func TestAgentCustomFieldsForUpdate(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/agent_fields", r.URL.Path)
+		fmt.Fprint(w, `{"agent_fields":[
+			{"id":1,"name":"email","label":"Email","type":"default_email","default":true,"mandatory_for_agents":true},
+			{"id":2,"name":"badge_id","label":"Badge ID","type":"custom_text","default":false,"mandatory_for_agents":true},
+			{"id":3,"name":"shift","label":"Shift","type":"custom_dropdown","default":false,"choices":[{"id":1,"value":"Day"},{"id":2,"value":"Night"}]}
+		]}`)
+	})
+	defer srv.Close()
+
+	fields, err := c.Agents().Fields(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, fields, 3)
+	assert.Equal(t, "Night", fields[2].Choices[1].Value)
+
+	update := freshservice.CustomFields{"shift": "Night"}
+	assert.EqualError(t, freshservice.ValidateCustomFields(fields, update), "invalid custom fields: badge_id is required")
+	assert.Nil(t, freshservice.ValidateCustomFieldsForUpdate(fields, update))
+
+	err = freshservice.ValidateCustomFieldsForUpdate(fields, freshservice.CustomFields{"badge_id": "", "shift": "Evening"})
+	assert.EqualError(t, err, "invalid custom fields: badge_id is required; shift does not allow the value Evening")
+}
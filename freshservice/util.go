@@ -1,6 +1,7 @@
 package freshservice
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -11,6 +12,11 @@ func Int(i int) *int {
 	return &i
 }
 
+// Bool is a built in utility function that will return a *bool
+func Bool(b bool) *bool {
+	return &b
+}
+
 // String is a built in utilty function that will return a *string
 func String(s string) *string {
 	return &s
@@ -27,6 +33,28 @@ func StringInSlice(a string, list []string) bool {
 	return false
 }
 
+// intInSlice reports whether n exists in a list of IDs
+func intInSlice(n int, list []int) bool {
+	for _, v := range list {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+// choiceIndex returns the index of the first of n dropdown choices whose value,
+// as returned by the func passed in, matches v, or -1 when none of them do
+func choiceIndex(n int, value func(int) string, v interface{}) int {
+	s := fmt.Sprint(v)
+	for i := 0; i < n; i++ {
+		if value(i) == s {
+			return i
+		}
+	}
+	return -1
+}
+
 // HasNextPage will take in an http response and check
 // for the existence of the "link" header to determine whether or
 // not there is another page returning the next page's URL